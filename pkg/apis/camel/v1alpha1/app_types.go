@@ -56,8 +56,24 @@ type CamelApp struct {
 	Status CamelAppStatus `json:"status,omitempty"`
 }
 
-// CamelAppSpec specifies the configuration of an App.
+// CamelAppSpec specifies the configuration of an App. It is only required for an App which is not imported, in which
+// case it declares the workload, or else the Pods, backing the App.
 type CamelAppSpec struct {
+	// the workload backing the App
+	// +optional
+	SourceRef *SourceRef `json:"sourceRef,omitempty"`
+	// the label selector of the Pods backing the App, when there is no workload
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// SourceRef references the workload backing an App.
+type SourceRef struct {
+	// the workload kind
+	// +kubebuilder:validation:Enum=Deployment;CronJob;KnativeService
+	Kind string `json:"kind"`
+	// the workload name
+	Name string `json:"name"`
 }
 
 // CamelAppStatus defines the observed state of an App.
@@ -74,8 +90,28 @@ type CamelAppStatus struct {
 	Info string `json:"info,omitempty"`
 	// The percentage of success rate
 	SuccessRate *SLIExchangeSuccessRate `json:"sliExchangeSuccessRate,omitempty"`
+	// The number of routes by state across all the pods
+	Routes *RoutesInfo `json:"routes,omitempty"`
 	// The conditions catching more detailed information
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// The workloads backing the application
+	Sources []SourceInfo `json:"sources,omitempty"`
+	// The last samples of the main App KPIs, oldest first
+	History []HistorySample `json:"history,omitempty"`
+}
+
+// HistorySample contains the main App KPIs, as sampled by a monitoring cycle.
+type HistorySample struct {
+	// the time of the sample
+	Timestamp metav1.Time `json:"timestamp"`
+	// the total number of exchanges across all the pods
+	ExchangesTotal int `json:"exchangesTotal"`
+	// the number of failed exchanges across all the pods
+	ExchangesFailed int `json:"exchangesFailed"`
+	// the exchange SLI status
+	SLIStatus SLIExchangeStatus `json:"sliStatus,omitempty"`
+	// the number of ready pods
+	ReadyPods int `json:"readyPods"`
 }
 
 // +kubebuilder:object:root=true
@@ -99,6 +135,16 @@ const (
 	CamelAppPhasePaused CamelAppPhase = "Paused"
 )
 
+// SourceInfo contains a set of information related to a workload backing the Camel application.
+type SourceInfo struct {
+	// the workload kind
+	Kind string `json:"kind,omitempty"`
+	// the workload name
+	Name string `json:"name,omitempty"`
+	// the workload phase
+	Phase CamelAppPhase `json:"phase,omitempty"`
+}
+
 // PodInfo contains a set of information related to the Pod running the Camel application.
 type PodInfo struct {
 	// the Pod name
@@ -131,8 +177,12 @@ type RuntimeInfo struct {
 	RuntimeVersion string `json:"runtimeVersion,omitempty"`
 	// the Camel core version
 	CamelVersion string `json:"camelVersion,omitempty"`
+	// the Camel context uptime timestamp
+	UptimeTimestamp *metav1.Time `json:"uptimeTimestamp,omitempty"`
 	// Information about the exchange
 	Exchange *ExchangeInfo `json:"exchange,omitempty"`
+	// Information about the routes
+	Routes *RoutesInfo `json:"routes,omitempty"`
 }
 
 // ObservabilityServiceInfo contains the endpoints that can be possibly used to scrape more information.
@@ -145,6 +195,10 @@ type ObservabilityServiceInfo struct {
 	MetricsEndpoint string `json:"metricsEndpoint,omitempty"`
 	// the metrics port
 	MetricsPort int `json:"metricsPort,omitempty"`
+	// the Jolokia endpoint
+	JolokiaEndpoint string `json:"jolokiaEndpoint,omitempty"`
+	// the Jolokia port
+	JolokiaPort int `json:"jolokiaPort,omitempty"`
 }
 
 // ExchangeInfo contains the endpoints that can be possibly used to scrape more information.
@@ -161,6 +215,19 @@ type ExchangeInfo struct {
 	LastTimestamp *metav1.Time `json:"lastTimestamp,omitempty"`
 }
 
+// RoutesInfo contains the number of routes by state. When the precise state is not available
+// (ie, the information comes from metrics), any route which is not running is accounted as stopped.
+type RoutesInfo struct {
+	// The total number of routes
+	Total int `json:"total,omitempty"`
+	// The number of started routes
+	Started int `json:"started,omitempty"`
+	// The number of suspended routes
+	Suspended int `json:"suspended,omitempty"`
+	// The number of stopped routes
+	Stopped int `json:"stopped,omitempty"`
+}
+
 // SLIExchangeStatus --.
 type SLIExchangeStatus string
 
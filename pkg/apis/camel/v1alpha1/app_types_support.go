@@ -20,6 +20,7 @@ package v1alpha1
 import (
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -34,14 +35,40 @@ const (
 	AppImportedKindLabel = "camel.apache.org/imported-from-kind"
 	// AppImportedNameLabel specifies from what resource an App was imported.
 	AppImportedNameLabel = "camel.apache.org/imported-from-name"
+	// AppAggregatedSourcesAnnotation lists the other workloads (as comma separated kind/name) aggregated into a synthetic App.
+	AppAggregatedSourcesAnnotation = "camel.apache.org/aggregated-sources"
+	// AppDashboardIgnoreAnnotation is used to exclude a workload from the import, even if it matches the label selector.
+	AppDashboardIgnoreAnnotation = "camel.apache.org/dashboard-ignore"
+	// AppDashboardImportAnnotation is used to include a workload in the import when the operator imports by annotation.
+	AppDashboardImportAnnotation = "camel.apache.org/dashboard-import"
 	// AppPollingIntervalSecondsAnnotation is used to instruct a given application to poll interval.
 	AppPollingIntervalSecondsAnnotation = "camel.apache.org/polling-interval-seconds"
 	// AppObservabilityServicesPort is used to instruct an application to use a specific port for metrics scraping.
 	AppObservabilityServicesPort = "camel.apache.org/observability-services-port"
+	// AppObservabilityMetricsEndpointAnnotation is used to instruct an application to use a specific path for metrics scraping.
+	AppObservabilityMetricsEndpointAnnotation = "camel.apache.org/observability-metrics-endpoint"
+	// AppObservabilityHealthEndpointAnnotation is used to instruct an application to use a specific path for health scraping.
+	AppObservabilityHealthEndpointAnnotation = "camel.apache.org/observability-health-endpoint"
+	// AppJolokiaScrapeAnnotation is used to instruct a given application to be inspected via Jolokia when metrics are not available.
+	AppJolokiaScrapeAnnotation = "camel.apache.org/jolokia-scrape"
+	// AppPrometheusResourcesAnnotation is used to instruct a given application to generate its PodMonitor and PrometheusRule.
+	AppPrometheusResourcesAnnotation = "camel.apache.org/prometheus-resources"
+	// AppMetricsPresetAnnotation is used to instruct a given application metric-name mapping preset (camel-main, quarkus or spring-boot).
+	AppMetricsPresetAnnotation = "camel.apache.org/metrics-preset"
+	// AppMetricsPrefixAnnotation is used to instruct a given application common prefix for the preset metric names.
+	AppMetricsPrefixAnnotation = "camel.apache.org/metrics-prefix"
+	// AppMetricsMappingAnnotation is used to instruct a given application metric names, as a comma separated list of metric=name pairs.
+	AppMetricsMappingAnnotation = "camel.apache.org/metrics-mapping"
 	// AppSLIExchangeErrorPercentageAnnotation is used to instruct a given application error percentage SLI Exchange.
 	AppSLIExchangeErrorPercentageAnnotation = "camel.apache.org/sli-exchange-error-percentage"
 	// AppSLIExchangeWarningPercentageAnnotation is used to instruct a given application warning percentage SLI Exchange.
 	AppSLIExchangeWarningPercentageAnnotation = "camel.apache.org/sli-exchange-warning-percentage"
+	// AppConditionHealthy is the condition reporting whether all the pods of an App are healthy.
+	AppConditionHealthy = "Healthy"
+	// AppConditionRoutesRunning is the condition reporting whether all the routes of an App are started.
+	AppConditionRoutesRunning = "RoutesRunning"
+	// AppConditionConflict is the condition reporting that the App name is claimed by several workloads.
+	AppConditionConflict = "Conflict"
 )
 
 func NewApp(namespace string, name string) CamelApp {
@@ -64,6 +91,11 @@ func (appStatus *CamelAppStatus) AddCondition(condition metav1.Condition) {
 	appStatus.Conditions = append(appStatus.Conditions, condition)
 }
 
+// GetCondition returns the condition of the given type, or nil if the App has no such condition.
+func (appStatus *CamelAppStatus) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(appStatus.Conditions, conditionType)
+}
+
 // ImportCamelAnnotations copies all camel annotations from the deployment to the App.
 func (app *CamelApp) ImportCamelAnnotations(annotations map[string]string) {
 	for k, v := range annotations {
@@ -72,3 +104,20 @@ func (app *CamelApp) ImportCamelAnnotations(annotations map[string]string) {
 		}
 	}
 }
+
+// SyncCamelAnnotations replaces all camel annotations of the App with the given ones, leaving the other annotations untouched.
+func (app *CamelApp) SyncCamelAnnotations(annotations map[string]string) {
+	for k := range app.Annotations {
+		if _, ok := annotations[k]; !ok && strings.HasPrefix(k, camelPrefix) {
+			delete(app.Annotations, k)
+		}
+	}
+	for k, v := range annotations {
+		if strings.HasPrefix(k, camelPrefix) {
+			if app.Annotations == nil {
+				app.Annotations = map[string]string{}
+			}
+			app.Annotations[k] = v
+		}
+	}
+}
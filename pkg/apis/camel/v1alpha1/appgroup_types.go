@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AppGroupKind --.
+	AppGroupKind string = "CamelAppGroup"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=camelappgroups,scope=Namespaced,shortName=capg,categories=camel
+// +kubebuilder:printcolumn:name="Apps",type=integer,JSONPath=`.status.apps`,description="The number of Camel Apps of the group"
+// +kubebuilder:printcolumn:name="Healthy Apps",type=integer,JSONPath=`.status.healthyApps`,description="The number of healthy Camel Apps of the group"
+// +kubebuilder:printcolumn:name="Health",type=string,JSONPath=`.status.health`,description="The group health"
+// +kubebuilder:printcolumn:name="Exchange SLI",type=string,JSONPath=`.status.sliExchangeSuccessRate.status`,description="The group success rate SLI"
+// +kubebuilder:printcolumn:name="Success",type=string,JSONPath=`.status.sliExchangeSuccessRate.successPercentage`,description="The group success percentage"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// CamelAppGroup is the Schema for the Camel Application groups API. A group gathers the Camel Applications which
+// make a logical system, and reports their aggregated health and exchange SLI.
+type CamelAppGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// the desired group specification
+	Spec CamelAppGroupSpec `json:"spec,omitempty"`
+	// the status of the group
+	Status CamelAppGroupStatus `json:"status,omitempty"`
+}
+
+// CamelAppGroupSpec specifies the Camel Applications of a group.
+type CamelAppGroupSpec struct {
+	// the label selector of the Camel Applications of the group
+	Selector metav1.LabelSelector `json:"selector"`
+	// the namespaces of the Camel Applications of the group, the group namespace when empty. Only a group of the
+	// operator namespace can select the Camel Applications of other namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// the percentage of failed exchanges of the group above which its SLI is in error
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SLIExchangeErrorPercentage *int `json:"sliExchangeErrorPercentage,omitempty"`
+	// the percentage of failed exchanges of the group above which its SLI is in warning
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SLIExchangeWarningPercentage *int `json:"sliExchangeWarningPercentage,omitempty"`
+}
+
+// CamelAppGroupHealth --.
+type CamelAppGroupHealth string
+
+const (
+	// CamelAppGroupHealthHealthy all the Apps of the group are healthy.
+	CamelAppGroupHealthHealthy CamelAppGroupHealth = "Healthy"
+	// CamelAppGroupHealthDegraded some Apps of the group are not healthy.
+	CamelAppGroupHealthDegraded CamelAppGroupHealth = "Degraded"
+	// CamelAppGroupHealthUnhealthy none of the Apps of the group is healthy.
+	CamelAppGroupHealthUnhealthy CamelAppGroupHealth = "Unhealthy"
+)
+
+// CamelAppGroupStatus defines the observed state of a group.
+type CamelAppGroupStatus struct {
+	// the generation of the group observed
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// the number of Apps of the group
+	Apps int `json:"apps"`
+	// the number of healthy Apps of the group
+	HealthyApps int `json:"healthyApps"`
+	// the aggregated health of the Apps of the group
+	Health CamelAppGroupHealth `json:"health,omitempty"`
+	// the combined success rate of the Apps of the group
+	SuccessRate *SLIExchangeSuccessRate `json:"sliExchangeSuccessRate,omitempty"`
+	// the Apps of the group
+	Members []AppGroupMember `json:"members,omitempty"`
+}
+
+// AppGroupMember references an App of a group along with its main KPIs.
+type AppGroupMember struct {
+	// the App namespace
+	Namespace string `json:"namespace"`
+	// the App name
+	Name string `json:"name"`
+	// the App phase
+	Phase CamelAppPhase `json:"phase,omitempty"`
+	// the App health
+	Healthy bool `json:"healthy"`
+	// the App exchange SLI status
+	SLIStatus SLIExchangeStatus `json:"sliStatus,omitempty"`
+	// the App exchange success percentage
+	SuccessPercentage string `json:"successPercentage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CamelAppGroupList contains a list of CamelAppGroups.
+type CamelAppGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CamelAppGroup `json:"items"`
+}
@@ -0,0 +1,132 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DashboardConfigKind --.
+	DashboardConfigKind string = "CamelDashboardConfig"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=cameldashboardconfigs,scope=Namespaced,shortName=cdc,categories=camel
+// +kubebuilder:printcolumn:name="Polling Interval",type=integer,JSONPath=`.spec.pollingIntervalSeconds`,description="The polling interval in seconds"
+// +kubebuilder:printcolumn:name="SLI Error",type=integer,JSONPath=`.spec.sliExchangeErrorPercentage`,description="The SLI exchange error percentage"
+// +kubebuilder:printcolumn:name="SLI Warning",type=integer,JSONPath=`.spec.sliExchangeWarningPercentage`,description="The SLI exchange warning percentage"
+// +kubebuilder:storageversion
+
+// CamelDashboardConfig is the Schema for the Camel Dashboard namespace configuration API. The settings apply to all the
+// Camel Applications of the namespace, unless the application overrides them with the related annotation. A single
+// configuration is expected by namespace: when there are several of them, the first one by name is used.
+type CamelDashboardConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// the desired configuration
+	Spec CamelDashboardConfigSpec `json:"spec,omitempty"`
+}
+
+// CamelDashboardConfigSpec specifies the configuration of the Camel Applications of a namespace.
+type CamelDashboardConfigSpec struct {
+	// the interval between two monitoring cycles
+	// +kubebuilder:validation:Minimum=1
+	PollingIntervalSeconds *int `json:"pollingIntervalSeconds,omitempty"`
+	// the percentage of failed exchanges above which the SLI is in error
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SLIExchangeErrorPercentage *int `json:"sliExchangeErrorPercentage,omitempty"`
+	// the percentage of failed exchanges above which the SLI is in warning
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SLIExchangeWarningPercentage *int `json:"sliExchangeWarningPercentage,omitempty"`
+	// the port exposing the observability services
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ObservabilityPort *int `json:"observabilityPort,omitempty"`
+	// the path of the metrics endpoint
+	MetricsEndpoint string `json:"metricsEndpoint,omitempty"`
+	// the path of the health endpoint
+	HealthEndpoint string `json:"healthEndpoint,omitempty"`
+	// inspect the applications via Jolokia when metrics are not available
+	JolokiaScrape *bool `json:"jolokiaScrape,omitempty"`
+	// the authentication required to scrape the observability services
+	Auth *ScrapeAuth `json:"auth,omitempty"`
+	// generate the PodMonitor and PrometheusRule of the applications, when the Prometheus Operator is installed
+	PrometheusResources *bool `json:"prometheusResources,omitempty"`
+	// the webhooks notified of the Camel Applications events of the namespace
+	Notifications []WebhookNotification `json:"notifications,omitempty"`
+}
+
+// WebhookFormat --.
+// +kubebuilder:validation:Enum=Generic;Slack;Teams
+type WebhookFormat string
+
+const (
+	// WebhookFormatGeneric posts the notification fields as a JSON object.
+	WebhookFormatGeneric WebhookFormat = "Generic"
+	// WebhookFormatSlack posts a Slack compatible incoming webhook message.
+	WebhookFormatSlack WebhookFormat = "Slack"
+	// WebhookFormatTeams posts a Microsoft Teams compatible incoming webhook message card.
+	WebhookFormatTeams WebhookFormat = "Teams"
+)
+
+// WebhookNotification routes the Camel Applications events of the namespace to a webhook.
+type WebhookNotification struct {
+	// the name of the Secret, in the same namespace, holding the webhook URL in its url key
+	SecretName string `json:"secretName"`
+	// the format of the posted payload, Generic by default
+	Format WebhookFormat `json:"format,omitempty"`
+	// the reasons of the notified events (ie, SLIStatusChanged), all of them when empty
+	Reasons []string `json:"reasons,omitempty"`
+	// notify the Warning events only
+	WarningOnly bool `json:"warningOnly,omitempty"`
+	// the Go template of the message, given the notification Namespace, App, Type, Reason, Message and Time
+	Template string `json:"template,omitempty"`
+}
+
+// ScrapeAuthType --.
+// +kubebuilder:validation:Enum=Basic;Bearer
+type ScrapeAuthType string
+
+const (
+	// ScrapeAuthTypeBasic uses the username and password keys of the Secret.
+	ScrapeAuthTypeBasic ScrapeAuthType = "Basic"
+	// ScrapeAuthTypeBearer uses the token key of the Secret.
+	ScrapeAuthTypeBearer ScrapeAuthType = "Bearer"
+)
+
+// ScrapeAuth contains the authentication required to scrape the observability services.
+type ScrapeAuth struct {
+	// the authentication type
+	Type ScrapeAuthType `json:"type"`
+	// the name of the Secret, in the same namespace, holding the credentials
+	SecretName string `json:"secretName"`
+}
+
+// +kubebuilder:object:root=true
+
+// CamelDashboardConfigList contains a list of CamelDashboardConfigs.
+type CamelDashboardConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CamelDashboardConfig `json:"items"`
+}
@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// FleetKind --.
+	FleetKind string = "CamelFleet"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=camelfleets,scope=Namespaced,shortName=cfleet,categories=camel
+// +kubebuilder:printcolumn:name="Apps",type=integer,JSONPath=`.status.apps`,description="The number of Camel Apps"
+// +kubebuilder:printcolumn:name="Running",type=integer,JSONPath=`.status.phases.Running`,description="The number of running Camel Apps"
+// +kubebuilder:printcolumn:name="SLI Error",type=integer,JSONPath=`.status.sliStatuses.Error`,description="The number of Camel Apps with an Error exchange SLI"
+// +kubebuilder:printcolumn:name="SLI Warning",type=integer,JSONPath=`.status.sliStatuses.Warning`,description="The number of Camel Apps with a Warning exchange SLI"
+// +kubebuilder:printcolumn:name="Last Update",type=date,JSONPath=`.status.lastUpdateTime`,description="Last summary change age"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// CamelFleet is the Schema for the Camel Dashboard fleet summary API. A fleet created in the operator namespace
+// summarizes all the Camel Applications monitored by the operator, a fleet created in any other namespace
+// summarizes the Camel Applications of its own namespace.
+type CamelFleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// the desired summary
+	Spec CamelFleetSpec `json:"spec,omitempty"`
+	// the summary of the Camel Applications
+	Status CamelFleetStatus `json:"status,omitempty"`
+}
+
+// CamelFleetSpec specifies the configuration of a fleet summary.
+type CamelFleetSpec struct {
+	// the maximum number of worst performing applications listed in the summary, 5 by default
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	WorstApps *int `json:"worstApps,omitempty"`
+}
+
+// CamelFleetStatus defines the observed summary of the Camel Applications.
+type CamelFleetStatus struct {
+	// the generation of the fleet summarized
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// the last time the summary changed
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// the number of applications
+	Apps int `json:"apps"`
+	// the number of applications by phase
+	Phases map[string]int `json:"phases,omitempty"`
+	// the number of applications by exchange SLI status
+	SLIStatuses map[string]int `json:"sliStatuses,omitempty"`
+	// the number of applications by runtime provider
+	RuntimeProviders map[string]int `json:"runtimeProviders,omitempty"`
+	// the number of applications by Camel version
+	CamelVersions map[string]int `json:"camelVersions,omitempty"`
+	// the degraded applications (in Error phase or with an Error or Warning exchange SLI), worst first
+	WorstApps []FleetApp `json:"worstApps,omitempty"`
+}
+
+// FleetApp references an application of the fleet along with its main KPIs.
+type FleetApp struct {
+	// the application namespace
+	Namespace string `json:"namespace"`
+	// the application name
+	Name string `json:"name"`
+	// the application phase
+	Phase CamelAppPhase `json:"phase,omitempty"`
+	// the exchange SLI status
+	SLIStatus SLIExchangeStatus `json:"sliStatus,omitempty"`
+	// the exchange success percentage
+	SuccessPercentage string `json:"successPercentage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CamelFleetList contains a list of CamelFleets.
+type CamelFleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CamelFleet `json:"items"`
+}
@@ -49,6 +49,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CamelApp{},
 		&CamelAppList{},
+		&CamelDashboardConfig{},
+		&CamelDashboardConfigList{},
+		&CamelFleet{},
+		&CamelFleetList{},
+		&CamelAppGroup{},
+		&CamelAppGroupList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -10,12 +10,27 @@ import (
 	timex "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppGroupMember) DeepCopyInto(out *AppGroupMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppGroupMember.
+func (in *AppGroupMember) DeepCopy() *AppGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(AppGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CamelApp) DeepCopyInto(out *CamelApp) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -37,6 +52,121 @@ func (in *CamelApp) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelAppGroup) DeepCopyInto(out *CamelAppGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppGroup.
+func (in *CamelAppGroup) DeepCopy() *CamelAppGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelAppGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelAppGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelAppGroupList) DeepCopyInto(out *CamelAppGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CamelAppGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppGroupList.
+func (in *CamelAppGroupList) DeepCopy() *CamelAppGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelAppGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelAppGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelAppGroupSpec) DeepCopyInto(out *CamelAppGroupSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SLIExchangeErrorPercentage != nil {
+		in, out := &in.SLIExchangeErrorPercentage, &out.SLIExchangeErrorPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.SLIExchangeWarningPercentage != nil {
+		in, out := &in.SLIExchangeWarningPercentage, &out.SLIExchangeWarningPercentage
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppGroupSpec.
+func (in *CamelAppGroupSpec) DeepCopy() *CamelAppGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelAppGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelAppGroupStatus) DeepCopyInto(out *CamelAppGroupStatus) {
+	*out = *in
+	if in.SuccessRate != nil {
+		in, out := &in.SuccessRate, &out.SuccessRate
+		*out = new(SLIExchangeSuccessRate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]AppGroupMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppGroupStatus.
+func (in *CamelAppGroupStatus) DeepCopy() *CamelAppGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelAppGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CamelAppList) DeepCopyInto(out *CamelAppList) {
 	*out = *in
@@ -72,6 +202,16 @@ func (in *CamelAppList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CamelAppSpec) DeepCopyInto(out *CamelAppSpec) {
 	*out = *in
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(SourceRef)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppSpec.
@@ -104,6 +244,11 @@ func (in *CamelAppStatus) DeepCopyInto(out *CamelAppStatus) {
 		*out = new(SLIExchangeSuccessRate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(RoutesInfo)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -111,6 +256,18 @@ func (in *CamelAppStatus) DeepCopyInto(out *CamelAppStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]SourceInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]HistorySample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelAppStatus.
@@ -123,6 +280,252 @@ func (in *CamelAppStatus) DeepCopy() *CamelAppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelDashboardConfig) DeepCopyInto(out *CamelDashboardConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelDashboardConfig.
+func (in *CamelDashboardConfig) DeepCopy() *CamelDashboardConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelDashboardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelDashboardConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelDashboardConfigList) DeepCopyInto(out *CamelDashboardConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CamelDashboardConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelDashboardConfigList.
+func (in *CamelDashboardConfigList) DeepCopy() *CamelDashboardConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelDashboardConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelDashboardConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelDashboardConfigSpec) DeepCopyInto(out *CamelDashboardConfigSpec) {
+	*out = *in
+	if in.PollingIntervalSeconds != nil {
+		in, out := &in.PollingIntervalSeconds, &out.PollingIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.SLIExchangeErrorPercentage != nil {
+		in, out := &in.SLIExchangeErrorPercentage, &out.SLIExchangeErrorPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.SLIExchangeWarningPercentage != nil {
+		in, out := &in.SLIExchangeWarningPercentage, &out.SLIExchangeWarningPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.ObservabilityPort != nil {
+		in, out := &in.ObservabilityPort, &out.ObservabilityPort
+		*out = new(int)
+		**out = **in
+	}
+	if in.JolokiaScrape != nil {
+		in, out := &in.JolokiaScrape, &out.JolokiaScrape
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(ScrapeAuth)
+		**out = **in
+	}
+	if in.PrometheusResources != nil {
+		in, out := &in.PrometheusResources, &out.PrometheusResources
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]WebhookNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelDashboardConfigSpec.
+func (in *CamelDashboardConfigSpec) DeepCopy() *CamelDashboardConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelDashboardConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelFleet) DeepCopyInto(out *CamelFleet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelFleet.
+func (in *CamelFleet) DeepCopy() *CamelFleet {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelFleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelFleet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelFleetList) DeepCopyInto(out *CamelFleetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CamelFleet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelFleetList.
+func (in *CamelFleetList) DeepCopy() *CamelFleetList {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelFleetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CamelFleetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelFleetSpec) DeepCopyInto(out *CamelFleetSpec) {
+	*out = *in
+	if in.WorstApps != nil {
+		in, out := &in.WorstApps, &out.WorstApps
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelFleetSpec.
+func (in *CamelFleetSpec) DeepCopy() *CamelFleetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelFleetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CamelFleetStatus) DeepCopyInto(out *CamelFleetStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SLIStatuses != nil {
+		in, out := &in.SLIStatuses, &out.SLIStatuses
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RuntimeProviders != nil {
+		in, out := &in.RuntimeProviders, &out.RuntimeProviders
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CamelVersions != nil {
+		in, out := &in.CamelVersions, &out.CamelVersions
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WorstApps != nil {
+		in, out := &in.WorstApps, &out.WorstApps
+		*out = make([]FleetApp, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CamelFleetStatus.
+func (in *CamelFleetStatus) DeepCopy() *CamelFleetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CamelFleetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExchangeInfo) DeepCopyInto(out *ExchangeInfo) {
 	*out = *in
@@ -142,6 +545,37 @@ func (in *ExchangeInfo) DeepCopy() *ExchangeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetApp) DeepCopyInto(out *FleetApp) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetApp.
+func (in *FleetApp) DeepCopy() *FleetApp {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetApp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistorySample) DeepCopyInto(out *HistorySample) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistorySample.
+func (in *HistorySample) DeepCopy() *HistorySample {
+	if in == nil {
+		return nil
+	}
+	out := new(HistorySample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservabilityServiceInfo) DeepCopyInto(out *ObservabilityServiceInfo) {
 	*out = *in
@@ -186,14 +620,38 @@ func (in *PodInfo) DeepCopy() *PodInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutesInfo) DeepCopyInto(out *RoutesInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutesInfo.
+func (in *RoutesInfo) DeepCopy() *RoutesInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutesInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeInfo) DeepCopyInto(out *RuntimeInfo) {
 	*out = *in
+	if in.UptimeTimestamp != nil {
+		in, out := &in.UptimeTimestamp, &out.UptimeTimestamp
+		*out = (*in).DeepCopy()
+	}
 	if in.Exchange != nil {
 		in, out := &in.Exchange, &out.Exchange
 		*out = new(ExchangeInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(RoutesInfo)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeInfo.
@@ -229,3 +687,68 @@ func (in *SLIExchangeSuccessRate) DeepCopy() *SLIExchangeSuccessRate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeAuth) DeepCopyInto(out *ScrapeAuth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeAuth.
+func (in *ScrapeAuth) DeepCopy() *ScrapeAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceInfo) DeepCopyInto(out *SourceInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceInfo.
+func (in *SourceInfo) DeepCopy() *SourceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceRef) DeepCopyInto(out *SourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceRef.
+func (in *SourceRef) DeepCopy() *SourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNotification) DeepCopyInto(out *WebhookNotification) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNotification.
+func (in *WebhookNotification) DeepCopy() *WebhookNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNotification)
+	in.DeepCopyInto(out)
+	return out
+}
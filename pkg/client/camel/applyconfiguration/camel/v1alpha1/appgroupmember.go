@@ -0,0 +1,97 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// AppGroupMemberApplyConfiguration represents a declarative configuration of the AppGroupMember type for use
+// with apply.
+//
+// AppGroupMember references an App of a group along with its main KPIs.
+type AppGroupMemberApplyConfiguration struct {
+	// the App namespace
+	Namespace *string `json:"namespace,omitempty"`
+	// the App name
+	Name *string `json:"name,omitempty"`
+	// the App phase
+	Phase *camelv1alpha1.CamelAppPhase `json:"phase,omitempty"`
+	// the App health
+	Healthy *bool `json:"healthy,omitempty"`
+	// the App exchange SLI status
+	SLIStatus *camelv1alpha1.SLIExchangeStatus `json:"sliStatus,omitempty"`
+	// the App exchange success percentage
+	SuccessPercentage *string `json:"successPercentage,omitempty"`
+}
+
+// AppGroupMemberApplyConfiguration constructs a declarative configuration of the AppGroupMember type for use with
+// apply.
+func AppGroupMember() *AppGroupMemberApplyConfiguration {
+	return &AppGroupMemberApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithNamespace(value string) *AppGroupMemberApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithName(value string) *AppGroupMemberApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithPhase(value camelv1alpha1.CamelAppPhase) *AppGroupMemberApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithHealthy sets the Healthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Healthy field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithHealthy(value bool) *AppGroupMemberApplyConfiguration {
+	b.Healthy = &value
+	return b
+}
+
+// WithSLIStatus sets the SLIStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIStatus field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithSLIStatus(value camelv1alpha1.SLIExchangeStatus) *AppGroupMemberApplyConfiguration {
+	b.SLIStatus = &value
+	return b
+}
+
+// WithSuccessPercentage sets the SuccessPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessPercentage field is set to the value of the last call.
+func (b *AppGroupMemberApplyConfiguration) WithSuccessPercentage(value string) *AppGroupMemberApplyConfiguration {
+	b.SuccessPercentage = &value
+	return b
+}
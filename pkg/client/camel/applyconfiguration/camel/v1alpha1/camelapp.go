@@ -20,7 +20,6 @@ limitations under the License.
 package v1alpha1
 
 import (
-	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -34,7 +33,7 @@ type CamelAppApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
 	// the desired App specification
-	Spec *camelv1alpha1.CamelAppSpec `json:"spec,omitempty"`
+	Spec *CamelAppSpecApplyConfiguration `json:"spec,omitempty"`
 	// the status of the App
 	Status *CamelAppStatusApplyConfiguration `json:"status,omitempty"`
 }
@@ -213,8 +212,8 @@ func (b *CamelAppApplyConfiguration) ensureObjectMetaApplyConfigurationExists()
 // WithSpec sets the Spec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Spec field is set to the value of the last call.
-func (b *CamelAppApplyConfiguration) WithSpec(value camelv1alpha1.CamelAppSpec) *CamelAppApplyConfiguration {
-	b.Spec = &value
+func (b *CamelAppApplyConfiguration) WithSpec(value *CamelAppSpecApplyConfiguration) *CamelAppApplyConfiguration {
+	b.Spec = value
 	return b
 }
 
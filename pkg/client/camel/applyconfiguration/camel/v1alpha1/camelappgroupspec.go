@@ -0,0 +1,80 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// CamelAppGroupSpecApplyConfiguration represents a declarative configuration of the CamelAppGroupSpec type for use
+// with apply.
+//
+// CamelAppGroupSpec specifies the Camel Applications of a group.
+type CamelAppGroupSpecApplyConfiguration struct {
+	// the label selector of the Camel Applications of the group
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// the namespaces of the Camel Applications of the group, the group namespace when empty. Only a group of the
+	// operator namespace can select the Camel Applications of other namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// the percentage of failed exchanges of the group above which its SLI is in error
+	SLIExchangeErrorPercentage *int `json:"sliExchangeErrorPercentage,omitempty"`
+	// the percentage of failed exchanges of the group above which its SLI is in warning
+	SLIExchangeWarningPercentage *int `json:"sliExchangeWarningPercentage,omitempty"`
+}
+
+// CamelAppGroupSpecApplyConfiguration constructs a declarative configuration of the CamelAppGroupSpec type for use with
+// apply.
+func CamelAppGroupSpec() *CamelAppGroupSpecApplyConfiguration {
+	return &CamelAppGroupSpecApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *CamelAppGroupSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *CamelAppGroupSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *CamelAppGroupSpecApplyConfiguration) WithNamespaces(values ...string) *CamelAppGroupSpecApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
+
+// WithSLIExchangeErrorPercentage sets the SLIExchangeErrorPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIExchangeErrorPercentage field is set to the value of the last call.
+func (b *CamelAppGroupSpecApplyConfiguration) WithSLIExchangeErrorPercentage(value int) *CamelAppGroupSpecApplyConfiguration {
+	b.SLIExchangeErrorPercentage = &value
+	return b
+}
+
+// WithSLIExchangeWarningPercentage sets the SLIExchangeWarningPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIExchangeWarningPercentage field is set to the value of the last call.
+func (b *CamelAppGroupSpecApplyConfiguration) WithSLIExchangeWarningPercentage(value int) *CamelAppGroupSpecApplyConfiguration {
+	b.SLIExchangeWarningPercentage = &value
+	return b
+}
@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// CamelAppGroupStatusApplyConfiguration represents a declarative configuration of the CamelAppGroupStatus type for use
+// with apply.
+//
+// CamelAppGroupStatus defines the observed state of a group.
+type CamelAppGroupStatusApplyConfiguration struct {
+	// the generation of the group observed
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// the number of Apps of the group
+	Apps *int `json:"apps,omitempty"`
+	// the number of healthy Apps of the group
+	HealthyApps *int `json:"healthyApps,omitempty"`
+	// the aggregated health of the Apps of the group
+	Health *camelv1alpha1.CamelAppGroupHealth `json:"health,omitempty"`
+	// the combined success rate of the Apps of the group
+	SuccessRate *SLIExchangeSuccessRateApplyConfiguration `json:"sliExchangeSuccessRate,omitempty"`
+	// the Apps of the group
+	Members []AppGroupMemberApplyConfiguration `json:"members,omitempty"`
+}
+
+// CamelAppGroupStatusApplyConfiguration constructs a declarative configuration of the CamelAppGroupStatus type for use with
+// apply.
+func CamelAppGroupStatus() *CamelAppGroupStatusApplyConfiguration {
+	return &CamelAppGroupStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *CamelAppGroupStatusApplyConfiguration) WithObservedGeneration(value int64) *CamelAppGroupStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithApps sets the Apps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Apps field is set to the value of the last call.
+func (b *CamelAppGroupStatusApplyConfiguration) WithApps(value int) *CamelAppGroupStatusApplyConfiguration {
+	b.Apps = &value
+	return b
+}
+
+// WithHealthyApps sets the HealthyApps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthyApps field is set to the value of the last call.
+func (b *CamelAppGroupStatusApplyConfiguration) WithHealthyApps(value int) *CamelAppGroupStatusApplyConfiguration {
+	b.HealthyApps = &value
+	return b
+}
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *CamelAppGroupStatusApplyConfiguration) WithHealth(value camelv1alpha1.CamelAppGroupHealth) *CamelAppGroupStatusApplyConfiguration {
+	b.Health = &value
+	return b
+}
+
+// WithSuccessRate sets the SuccessRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessRate field is set to the value of the last call.
+func (b *CamelAppGroupStatusApplyConfiguration) WithSuccessRate(value *SLIExchangeSuccessRateApplyConfiguration) *CamelAppGroupStatusApplyConfiguration {
+	b.SuccessRate = value
+	return b
+}
+
+// WithMembers adds the given value to the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Members field.
+func (b *CamelAppGroupStatusApplyConfiguration) WithMembers(values ...*AppGroupMemberApplyConfiguration) *CamelAppGroupStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMembers")
+		}
+		b.Members = append(b.Members, *values[i])
+	}
+	return b
+}
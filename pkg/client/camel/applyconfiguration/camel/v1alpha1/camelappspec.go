@@ -0,0 +1,58 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// CamelAppSpecApplyConfiguration represents a declarative configuration of the CamelAppSpec type for use
+// with apply.
+//
+// CamelAppSpec specifies the configuration of an App. It is only required for an App which is not imported, in which
+// case it declares the workload, or else the Pods, backing the App.
+type CamelAppSpecApplyConfiguration struct {
+	// the workload backing the App
+	SourceRef *SourceRefApplyConfiguration `json:"sourceRef,omitempty"`
+	// the label selector of the Pods backing the App, when there is no workload
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+}
+
+// CamelAppSpecApplyConfiguration constructs a declarative configuration of the CamelAppSpec type for use with
+// apply.
+func CamelAppSpec() *CamelAppSpecApplyConfiguration {
+	return &CamelAppSpecApplyConfiguration{}
+}
+
+// WithSourceRef sets the SourceRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceRef field is set to the value of the last call.
+func (b *CamelAppSpecApplyConfiguration) WithSourceRef(value *SourceRefApplyConfiguration) *CamelAppSpecApplyConfiguration {
+	b.SourceRef = value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *CamelAppSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *CamelAppSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
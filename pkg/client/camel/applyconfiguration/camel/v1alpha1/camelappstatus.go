@@ -41,8 +41,14 @@ type CamelAppStatusApplyConfiguration struct {
 	Info *string `json:"info,omitempty"`
 	// The percentage of success rate
 	SuccessRate *SLIExchangeSuccessRateApplyConfiguration `json:"sliExchangeSuccessRate,omitempty"`
+	// The number of routes by state across all the pods
+	Routes *RoutesInfoApplyConfiguration `json:"routes,omitempty"`
 	// The conditions catching more detailed information
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The workloads backing the application
+	Sources []SourceInfoApplyConfiguration `json:"sources,omitempty"`
+	// The last samples of the main App KPIs, oldest first
+	History []HistorySampleApplyConfiguration `json:"history,omitempty"`
 }
 
 // CamelAppStatusApplyConfiguration constructs a declarative configuration of the CamelAppStatus type for use with
@@ -104,6 +110,14 @@ func (b *CamelAppStatusApplyConfiguration) WithSuccessRate(value *SLIExchangeSuc
 	return b
 }
 
+// WithRoutes sets the Routes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Routes field is set to the value of the last call.
+func (b *CamelAppStatusApplyConfiguration) WithRoutes(value *RoutesInfoApplyConfiguration) *CamelAppStatusApplyConfiguration {
+	b.Routes = value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -116,3 +130,29 @@ func (b *CamelAppStatusApplyConfiguration) WithConditions(values ...*v1.Conditio
 	}
 	return b
 }
+
+// WithSources adds the given value to the Sources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Sources field.
+func (b *CamelAppStatusApplyConfiguration) WithSources(values ...*SourceInfoApplyConfiguration) *CamelAppStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSources")
+		}
+		b.Sources = append(b.Sources, *values[i])
+	}
+	return b
+}
+
+// WithHistory adds the given value to the History field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the History field.
+func (b *CamelAppStatusApplyConfiguration) WithHistory(values ...*HistorySampleApplyConfiguration) *CamelAppStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHistory")
+		}
+		b.History = append(b.History, *values[i])
+	}
+	return b
+}
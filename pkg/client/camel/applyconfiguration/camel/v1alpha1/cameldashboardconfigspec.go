@@ -0,0 +1,138 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CamelDashboardConfigSpecApplyConfiguration represents a declarative configuration of the CamelDashboardConfigSpec type for use
+// with apply.
+//
+// CamelDashboardConfigSpec specifies the configuration of the Camel Applications of a namespace.
+type CamelDashboardConfigSpecApplyConfiguration struct {
+	// the interval between two monitoring cycles
+	PollingIntervalSeconds *int `json:"pollingIntervalSeconds,omitempty"`
+	// the percentage of failed exchanges above which the SLI is in error
+	SLIExchangeErrorPercentage *int `json:"sliExchangeErrorPercentage,omitempty"`
+	// the percentage of failed exchanges above which the SLI is in warning
+	SLIExchangeWarningPercentage *int `json:"sliExchangeWarningPercentage,omitempty"`
+	// the port exposing the observability services
+	ObservabilityPort *int `json:"observabilityPort,omitempty"`
+	// the path of the metrics endpoint
+	MetricsEndpoint *string `json:"metricsEndpoint,omitempty"`
+	// the path of the health endpoint
+	HealthEndpoint *string `json:"healthEndpoint,omitempty"`
+	// inspect the applications via Jolokia when metrics are not available
+	JolokiaScrape *bool `json:"jolokiaScrape,omitempty"`
+	// the authentication required to scrape the observability services
+	Auth *ScrapeAuthApplyConfiguration `json:"auth,omitempty"`
+	// generate the PodMonitor and PrometheusRule of the applications, when the Prometheus Operator is installed
+	PrometheusResources *bool `json:"prometheusResources,omitempty"`
+	// the webhooks notified of the Camel Applications events of the namespace
+	Notifications []WebhookNotificationApplyConfiguration `json:"notifications,omitempty"`
+}
+
+// CamelDashboardConfigSpecApplyConfiguration constructs a declarative configuration of the CamelDashboardConfigSpec type for use with
+// apply.
+func CamelDashboardConfigSpec() *CamelDashboardConfigSpecApplyConfiguration {
+	return &CamelDashboardConfigSpecApplyConfiguration{}
+}
+
+// WithPollingIntervalSeconds sets the PollingIntervalSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PollingIntervalSeconds field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithPollingIntervalSeconds(value int) *CamelDashboardConfigSpecApplyConfiguration {
+	b.PollingIntervalSeconds = &value
+	return b
+}
+
+// WithSLIExchangeErrorPercentage sets the SLIExchangeErrorPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIExchangeErrorPercentage field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithSLIExchangeErrorPercentage(value int) *CamelDashboardConfigSpecApplyConfiguration {
+	b.SLIExchangeErrorPercentage = &value
+	return b
+}
+
+// WithSLIExchangeWarningPercentage sets the SLIExchangeWarningPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIExchangeWarningPercentage field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithSLIExchangeWarningPercentage(value int) *CamelDashboardConfigSpecApplyConfiguration {
+	b.SLIExchangeWarningPercentage = &value
+	return b
+}
+
+// WithObservabilityPort sets the ObservabilityPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservabilityPort field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithObservabilityPort(value int) *CamelDashboardConfigSpecApplyConfiguration {
+	b.ObservabilityPort = &value
+	return b
+}
+
+// WithMetricsEndpoint sets the MetricsEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsEndpoint field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithMetricsEndpoint(value string) *CamelDashboardConfigSpecApplyConfiguration {
+	b.MetricsEndpoint = &value
+	return b
+}
+
+// WithHealthEndpoint sets the HealthEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthEndpoint field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithHealthEndpoint(value string) *CamelDashboardConfigSpecApplyConfiguration {
+	b.HealthEndpoint = &value
+	return b
+}
+
+// WithJolokiaScrape sets the JolokiaScrape field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JolokiaScrape field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithJolokiaScrape(value bool) *CamelDashboardConfigSpecApplyConfiguration {
+	b.JolokiaScrape = &value
+	return b
+}
+
+// WithAuth sets the Auth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Auth field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithAuth(value *ScrapeAuthApplyConfiguration) *CamelDashboardConfigSpecApplyConfiguration {
+	b.Auth = value
+	return b
+}
+
+// WithPrometheusResources sets the PrometheusResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrometheusResources field is set to the value of the last call.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithPrometheusResources(value bool) *CamelDashboardConfigSpecApplyConfiguration {
+	b.PrometheusResources = &value
+	return b
+}
+
+// WithNotifications adds the given value to the Notifications field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Notifications field.
+func (b *CamelDashboardConfigSpecApplyConfiguration) WithNotifications(values ...*WebhookNotificationApplyConfiguration) *CamelDashboardConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNotifications")
+		}
+		b.Notifications = append(b.Notifications, *values[i])
+	}
+	return b
+}
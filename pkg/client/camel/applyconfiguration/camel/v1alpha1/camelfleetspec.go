@@ -0,0 +1,43 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CamelFleetSpecApplyConfiguration represents a declarative configuration of the CamelFleetSpec type for use
+// with apply.
+//
+// CamelFleetSpec specifies the configuration of a fleet summary.
+type CamelFleetSpecApplyConfiguration struct {
+	// the maximum number of worst performing applications listed in the summary, 5 by default
+	WorstApps *int `json:"worstApps,omitempty"`
+}
+
+// CamelFleetSpecApplyConfiguration constructs a declarative configuration of the CamelFleetSpec type for use with
+// apply.
+func CamelFleetSpec() *CamelFleetSpecApplyConfiguration {
+	return &CamelFleetSpecApplyConfiguration{}
+}
+
+// WithWorstApps sets the WorstApps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorstApps field is set to the value of the last call.
+func (b *CamelFleetSpecApplyConfiguration) WithWorstApps(value int) *CamelFleetSpecApplyConfiguration {
+	b.WorstApps = &value
+	return b
+}
@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CamelFleetStatusApplyConfiguration represents a declarative configuration of the CamelFleetStatus type for use
+// with apply.
+//
+// CamelFleetStatus defines the observed summary of the Camel Applications.
+type CamelFleetStatusApplyConfiguration struct {
+	// the generation of the fleet summarized
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// the last time the summary changed
+	LastUpdateTime *v1.Time `json:"lastUpdateTime,omitempty"`
+	// the number of applications
+	Apps *int `json:"apps,omitempty"`
+	// the number of applications by phase
+	Phases map[string]int `json:"phases,omitempty"`
+	// the number of applications by exchange SLI status
+	SLIStatuses map[string]int `json:"sliStatuses,omitempty"`
+	// the number of applications by runtime provider
+	RuntimeProviders map[string]int `json:"runtimeProviders,omitempty"`
+	// the number of applications by Camel version
+	CamelVersions map[string]int `json:"camelVersions,omitempty"`
+	// the degraded applications (in Error phase or with an Error or Warning exchange SLI), worst first
+	WorstApps []FleetAppApplyConfiguration `json:"worstApps,omitempty"`
+}
+
+// CamelFleetStatusApplyConfiguration constructs a declarative configuration of the CamelFleetStatus type for use with
+// apply.
+func CamelFleetStatus() *CamelFleetStatusApplyConfiguration {
+	return &CamelFleetStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *CamelFleetStatusApplyConfiguration) WithObservedGeneration(value int64) *CamelFleetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithLastUpdateTime sets the LastUpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastUpdateTime field is set to the value of the last call.
+func (b *CamelFleetStatusApplyConfiguration) WithLastUpdateTime(value v1.Time) *CamelFleetStatusApplyConfiguration {
+	b.LastUpdateTime = &value
+	return b
+}
+
+// WithApps sets the Apps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Apps field is set to the value of the last call.
+func (b *CamelFleetStatusApplyConfiguration) WithApps(value int) *CamelFleetStatusApplyConfiguration {
+	b.Apps = &value
+	return b
+}
+
+// WithPhases puts the entries into the Phases field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Phases field,
+// overwriting an existing map entries in Phases field with the same key.
+func (b *CamelFleetStatusApplyConfiguration) WithPhases(entries map[string]int) *CamelFleetStatusApplyConfiguration {
+	if b.Phases == nil && len(entries) > 0 {
+		b.Phases = make(map[string]int, len(entries))
+	}
+	for k, v := range entries {
+		b.Phases[k] = v
+	}
+	return b
+}
+
+// WithSLIStatuses puts the entries into the SLIStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the SLIStatuses field,
+// overwriting an existing map entries in SLIStatuses field with the same key.
+func (b *CamelFleetStatusApplyConfiguration) WithSLIStatuses(entries map[string]int) *CamelFleetStatusApplyConfiguration {
+	if b.SLIStatuses == nil && len(entries) > 0 {
+		b.SLIStatuses = make(map[string]int, len(entries))
+	}
+	for k, v := range entries {
+		b.SLIStatuses[k] = v
+	}
+	return b
+}
+
+// WithRuntimeProviders puts the entries into the RuntimeProviders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the RuntimeProviders field,
+// overwriting an existing map entries in RuntimeProviders field with the same key.
+func (b *CamelFleetStatusApplyConfiguration) WithRuntimeProviders(entries map[string]int) *CamelFleetStatusApplyConfiguration {
+	if b.RuntimeProviders == nil && len(entries) > 0 {
+		b.RuntimeProviders = make(map[string]int, len(entries))
+	}
+	for k, v := range entries {
+		b.RuntimeProviders[k] = v
+	}
+	return b
+}
+
+// WithCamelVersions puts the entries into the CamelVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the CamelVersions field,
+// overwriting an existing map entries in CamelVersions field with the same key.
+func (b *CamelFleetStatusApplyConfiguration) WithCamelVersions(entries map[string]int) *CamelFleetStatusApplyConfiguration {
+	if b.CamelVersions == nil && len(entries) > 0 {
+		b.CamelVersions = make(map[string]int, len(entries))
+	}
+	for k, v := range entries {
+		b.CamelVersions[k] = v
+	}
+	return b
+}
+
+// WithWorstApps adds the given value to the WorstApps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorstApps field.
+func (b *CamelFleetStatusApplyConfiguration) WithWorstApps(values ...*FleetAppApplyConfiguration) *CamelFleetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorstApps")
+		}
+		b.WorstApps = append(b.WorstApps, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HistorySampleApplyConfiguration represents a declarative configuration of the HistorySample type for use
+// with apply.
+//
+// HistorySample contains the main App KPIs, as sampled by a monitoring cycle.
+type HistorySampleApplyConfiguration struct {
+	// the time of the sample
+	Timestamp *v1.Time `json:"timestamp,omitempty"`
+	// the total number of exchanges across all the pods
+	ExchangesTotal *int `json:"exchangesTotal,omitempty"`
+	// the number of failed exchanges across all the pods
+	ExchangesFailed *int `json:"exchangesFailed,omitempty"`
+	// the exchange SLI status
+	SLIStatus *camelv1alpha1.SLIExchangeStatus `json:"sliStatus,omitempty"`
+	// the number of ready pods
+	ReadyPods *int `json:"readyPods,omitempty"`
+}
+
+// HistorySampleApplyConfiguration constructs a declarative configuration of the HistorySample type for use with
+// apply.
+func HistorySample() *HistorySampleApplyConfiguration {
+	return &HistorySampleApplyConfiguration{}
+}
+
+// WithTimestamp sets the Timestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timestamp field is set to the value of the last call.
+func (b *HistorySampleApplyConfiguration) WithTimestamp(value v1.Time) *HistorySampleApplyConfiguration {
+	b.Timestamp = &value
+	return b
+}
+
+// WithExchangesTotal sets the ExchangesTotal field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExchangesTotal field is set to the value of the last call.
+func (b *HistorySampleApplyConfiguration) WithExchangesTotal(value int) *HistorySampleApplyConfiguration {
+	b.ExchangesTotal = &value
+	return b
+}
+
+// WithExchangesFailed sets the ExchangesFailed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExchangesFailed field is set to the value of the last call.
+func (b *HistorySampleApplyConfiguration) WithExchangesFailed(value int) *HistorySampleApplyConfiguration {
+	b.ExchangesFailed = &value
+	return b
+}
+
+// WithSLIStatus sets the SLIStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SLIStatus field is set to the value of the last call.
+func (b *HistorySampleApplyConfiguration) WithSLIStatus(value camelv1alpha1.SLIExchangeStatus) *HistorySampleApplyConfiguration {
+	b.SLIStatus = &value
+	return b
+}
+
+// WithReadyPods sets the ReadyPods field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyPods field is set to the value of the last call.
+func (b *HistorySampleApplyConfiguration) WithReadyPods(value int) *HistorySampleApplyConfiguration {
+	b.ReadyPods = &value
+	return b
+}
@@ -32,6 +32,10 @@ type ObservabilityServiceInfoApplyConfiguration struct {
 	MetricsEndpoint *string `json:"metricsEndpoint,omitempty"`
 	// the metrics port
 	MetricsPort *int `json:"metricsPort,omitempty"`
+	// the Jolokia endpoint
+	JolokiaEndpoint *string `json:"jolokiaEndpoint,omitempty"`
+	// the Jolokia port
+	JolokiaPort *int `json:"jolokiaPort,omitempty"`
 }
 
 // ObservabilityServiceInfoApplyConfiguration constructs a declarative configuration of the ObservabilityServiceInfo type for use with
@@ -71,3 +75,19 @@ func (b *ObservabilityServiceInfoApplyConfiguration) WithMetricsPort(value int)
 	b.MetricsPort = &value
 	return b
 }
+
+// WithJolokiaEndpoint sets the JolokiaEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JolokiaEndpoint field is set to the value of the last call.
+func (b *ObservabilityServiceInfoApplyConfiguration) WithJolokiaEndpoint(value string) *ObservabilityServiceInfoApplyConfiguration {
+	b.JolokiaEndpoint = &value
+	return b
+}
+
+// WithJolokiaPort sets the JolokiaPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JolokiaPort field is set to the value of the last call.
+func (b *ObservabilityServiceInfoApplyConfiguration) WithJolokiaPort(value int) *ObservabilityServiceInfoApplyConfiguration {
+	b.JolokiaPort = &value
+	return b
+}
@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RoutesInfoApplyConfiguration represents a declarative configuration of the RoutesInfo type for use
+// with apply.
+//
+// RoutesInfo contains the number of routes by state. When the precise state is not available
+// (ie, the information comes from metrics), any route which is not running is accounted as stopped.
+type RoutesInfoApplyConfiguration struct {
+	// The total number of routes
+	Total *int `json:"total,omitempty"`
+	// The number of started routes
+	Started *int `json:"started,omitempty"`
+	// The number of suspended routes
+	Suspended *int `json:"suspended,omitempty"`
+	// The number of stopped routes
+	Stopped *int `json:"stopped,omitempty"`
+}
+
+// RoutesInfoApplyConfiguration constructs a declarative configuration of the RoutesInfo type for use with
+// apply.
+func RoutesInfo() *RoutesInfoApplyConfiguration {
+	return &RoutesInfoApplyConfiguration{}
+}
+
+// WithTotal sets the Total field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Total field is set to the value of the last call.
+func (b *RoutesInfoApplyConfiguration) WithTotal(value int) *RoutesInfoApplyConfiguration {
+	b.Total = &value
+	return b
+}
+
+// WithStarted sets the Started field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Started field is set to the value of the last call.
+func (b *RoutesInfoApplyConfiguration) WithStarted(value int) *RoutesInfoApplyConfiguration {
+	b.Started = &value
+	return b
+}
+
+// WithSuspended sets the Suspended field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspended field is set to the value of the last call.
+func (b *RoutesInfoApplyConfiguration) WithSuspended(value int) *RoutesInfoApplyConfiguration {
+	b.Suspended = &value
+	return b
+}
+
+// WithStopped sets the Stopped field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stopped field is set to the value of the last call.
+func (b *RoutesInfoApplyConfiguration) WithStopped(value int) *RoutesInfoApplyConfiguration {
+	b.Stopped = &value
+	return b
+}
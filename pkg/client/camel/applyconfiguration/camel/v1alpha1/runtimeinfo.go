@@ -19,6 +19,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // RuntimeInfoApplyConfiguration represents a declarative configuration of the RuntimeInfo type for use
 // with apply.
 //
@@ -32,8 +36,12 @@ type RuntimeInfoApplyConfiguration struct {
 	RuntimeVersion *string `json:"runtimeVersion,omitempty"`
 	// the Camel core version
 	CamelVersion *string `json:"camelVersion,omitempty"`
+	// the Camel context uptime timestamp
+	UptimeTimestamp *v1.Time `json:"uptimeTimestamp,omitempty"`
 	// Information about the exchange
 	Exchange *ExchangeInfoApplyConfiguration `json:"exchange,omitempty"`
+	// Information about the routes
+	Routes *RoutesInfoApplyConfiguration `json:"routes,omitempty"`
 }
 
 // RuntimeInfoApplyConfiguration constructs a declarative configuration of the RuntimeInfo type for use with
@@ -74,6 +82,14 @@ func (b *RuntimeInfoApplyConfiguration) WithCamelVersion(value string) *RuntimeI
 	return b
 }
 
+// WithUptimeTimestamp sets the UptimeTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UptimeTimestamp field is set to the value of the last call.
+func (b *RuntimeInfoApplyConfiguration) WithUptimeTimestamp(value v1.Time) *RuntimeInfoApplyConfiguration {
+	b.UptimeTimestamp = &value
+	return b
+}
+
 // WithExchange sets the Exchange field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Exchange field is set to the value of the last call.
@@ -81,3 +97,11 @@ func (b *RuntimeInfoApplyConfiguration) WithExchange(value *ExchangeInfoApplyCon
 	b.Exchange = value
 	return b
 }
+
+// WithRoutes sets the Routes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Routes field is set to the value of the last call.
+func (b *RuntimeInfoApplyConfiguration) WithRoutes(value *RoutesInfoApplyConfiguration) *RuntimeInfoApplyConfiguration {
+	b.Routes = value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// ScrapeAuthApplyConfiguration represents a declarative configuration of the ScrapeAuth type for use
+// with apply.
+//
+// ScrapeAuth contains the authentication required to scrape the observability services.
+type ScrapeAuthApplyConfiguration struct {
+	// the authentication type
+	Type *camelv1alpha1.ScrapeAuthType `json:"type,omitempty"`
+	// the name of the Secret, in the same namespace, holding the credentials
+	SecretName *string `json:"secretName,omitempty"`
+}
+
+// ScrapeAuthApplyConfiguration constructs a declarative configuration of the ScrapeAuth type for use with
+// apply.
+func ScrapeAuth() *ScrapeAuthApplyConfiguration {
+	return &ScrapeAuthApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ScrapeAuthApplyConfiguration) WithType(value camelv1alpha1.ScrapeAuthType) *ScrapeAuthApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *ScrapeAuthApplyConfiguration) WithSecretName(value string) *ScrapeAuthApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
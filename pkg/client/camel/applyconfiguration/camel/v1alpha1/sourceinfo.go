@@ -0,0 +1,67 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// SourceInfoApplyConfiguration represents a declarative configuration of the SourceInfo type for use
+// with apply.
+//
+// SourceInfo contains a set of information related to a workload backing the Camel application.
+type SourceInfoApplyConfiguration struct {
+	// the workload kind
+	Kind *string `json:"kind,omitempty"`
+	// the workload name
+	Name *string `json:"name,omitempty"`
+	// the workload phase
+	Phase *camelv1alpha1.CamelAppPhase `json:"phase,omitempty"`
+}
+
+// SourceInfoApplyConfiguration constructs a declarative configuration of the SourceInfo type for use with
+// apply.
+func SourceInfo() *SourceInfoApplyConfiguration {
+	return &SourceInfoApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *SourceInfoApplyConfiguration) WithKind(value string) *SourceInfoApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SourceInfoApplyConfiguration) WithName(value string) *SourceInfoApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *SourceInfoApplyConfiguration) WithPhase(value camelv1alpha1.CamelAppPhase) *SourceInfoApplyConfiguration {
+	b.Phase = &value
+	return b
+}
@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// WebhookNotificationApplyConfiguration represents a declarative configuration of the WebhookNotification type for use
+// with apply.
+//
+// WebhookNotification routes the Camel Applications events of the namespace to a webhook.
+type WebhookNotificationApplyConfiguration struct {
+	// the name of the Secret, in the same namespace, holding the webhook URL in its url key
+	SecretName *string `json:"secretName,omitempty"`
+	// the format of the posted payload, Generic by default
+	Format *camelv1alpha1.WebhookFormat `json:"format,omitempty"`
+	// the reasons of the notified events (ie, SLIStatusChanged), all of them when empty
+	Reasons []string `json:"reasons,omitempty"`
+	// notify the Warning events only
+	WarningOnly *bool `json:"warningOnly,omitempty"`
+	// the Go template of the message, given the notification Namespace, App, Type, Reason, Message and Time
+	Template *string `json:"template,omitempty"`
+}
+
+// WebhookNotificationApplyConfiguration constructs a declarative configuration of the WebhookNotification type for use with
+// apply.
+func WebhookNotification() *WebhookNotificationApplyConfiguration {
+	return &WebhookNotificationApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *WebhookNotificationApplyConfiguration) WithSecretName(value string) *WebhookNotificationApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithFormat sets the Format field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Format field is set to the value of the last call.
+func (b *WebhookNotificationApplyConfiguration) WithFormat(value camelv1alpha1.WebhookFormat) *WebhookNotificationApplyConfiguration {
+	b.Format = &value
+	return b
+}
+
+// WithReasons adds the given value to the Reasons field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Reasons field.
+func (b *WebhookNotificationApplyConfiguration) WithReasons(values ...string) *WebhookNotificationApplyConfiguration {
+	for i := range values {
+		b.Reasons = append(b.Reasons, values[i])
+	}
+	return b
+}
+
+// WithWarningOnly sets the WarningOnly field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarningOnly field is set to the value of the last call.
+func (b *WebhookNotificationApplyConfiguration) WithWarningOnly(value bool) *WebhookNotificationApplyConfiguration {
+	b.WarningOnly = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *WebhookNotificationApplyConfiguration) WithTemplate(value string) *WebhookNotificationApplyConfiguration {
+	b.Template = &value
+	return b
+}
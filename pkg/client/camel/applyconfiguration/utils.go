@@ -33,20 +33,54 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=camel.apache.org, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AppGroupMember"):
+		return &camelv1alpha1.AppGroupMemberApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CamelApp"):
 		return &camelv1alpha1.CamelAppApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelAppGroup"):
+		return &camelv1alpha1.CamelAppGroupApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelAppGroupSpec"):
+		return &camelv1alpha1.CamelAppGroupSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelAppGroupStatus"):
+		return &camelv1alpha1.CamelAppGroupStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelAppSpec"):
+		return &camelv1alpha1.CamelAppSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CamelAppStatus"):
 		return &camelv1alpha1.CamelAppStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelDashboardConfig"):
+		return &camelv1alpha1.CamelDashboardConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelDashboardConfigSpec"):
+		return &camelv1alpha1.CamelDashboardConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelFleet"):
+		return &camelv1alpha1.CamelFleetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelFleetSpec"):
+		return &camelv1alpha1.CamelFleetSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CamelFleetStatus"):
+		return &camelv1alpha1.CamelFleetStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ExchangeInfo"):
 		return &camelv1alpha1.ExchangeInfoApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FleetApp"):
+		return &camelv1alpha1.FleetAppApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HistorySample"):
+		return &camelv1alpha1.HistorySampleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ObservabilityServiceInfo"):
 		return &camelv1alpha1.ObservabilityServiceInfoApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PodInfo"):
 		return &camelv1alpha1.PodInfoApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RoutesInfo"):
+		return &camelv1alpha1.RoutesInfoApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeInfo"):
 		return &camelv1alpha1.RuntimeInfoApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeAuth"):
+		return &camelv1alpha1.ScrapeAuthApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SLIExchangeSuccessRate"):
 		return &camelv1alpha1.SLIExchangeSuccessRateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SourceInfo"):
+		return &camelv1alpha1.SourceInfoApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SourceRef"):
+		return &camelv1alpha1.SourceRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WebhookNotification"):
+		return &camelv1alpha1.WebhookNotificationApplyConfiguration{}
 
 	}
 	return nil
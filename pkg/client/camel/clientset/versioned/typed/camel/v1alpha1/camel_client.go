@@ -30,6 +30,9 @@ import (
 type CamelV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	CamelAppsGetter
+	CamelAppGroupsGetter
+	CamelDashboardConfigsGetter
+	CamelFleetsGetter
 }
 
 // CamelV1alpha1Client is used to interact with features provided by the camel.apache.org group.
@@ -41,6 +44,18 @@ func (c *CamelV1alpha1Client) CamelApps(namespace string) CamelAppInterface {
 	return newCamelApps(c, namespace)
 }
 
+func (c *CamelV1alpha1Client) CamelAppGroups(namespace string) CamelAppGroupInterface {
+	return newCamelAppGroups(c, namespace)
+}
+
+func (c *CamelV1alpha1Client) CamelDashboardConfigs(namespace string) CamelDashboardConfigInterface {
+	return newCamelDashboardConfigs(c, namespace)
+}
+
+func (c *CamelV1alpha1Client) CamelFleets(namespace string) CamelFleetInterface {
+	return newCamelFleets(c, namespace)
+}
+
 // NewForConfig creates a new CamelV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
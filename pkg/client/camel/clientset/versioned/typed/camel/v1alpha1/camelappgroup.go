@@ -0,0 +1,75 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	applyconfigurationcamelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/applyconfiguration/camel/v1alpha1"
+	scheme "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// CamelAppGroupsGetter has a method to return a CamelAppGroupInterface.
+// A group's client should implement this interface.
+type CamelAppGroupsGetter interface {
+	CamelAppGroups(namespace string) CamelAppGroupInterface
+}
+
+// CamelAppGroupInterface has methods to work with CamelAppGroup resources.
+type CamelAppGroupInterface interface {
+	Create(ctx context.Context, camelAppGroup *camelv1alpha1.CamelAppGroup, opts v1.CreateOptions) (*camelv1alpha1.CamelAppGroup, error)
+	Update(ctx context.Context, camelAppGroup *camelv1alpha1.CamelAppGroup, opts v1.UpdateOptions) (*camelv1alpha1.CamelAppGroup, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, camelAppGroup *camelv1alpha1.CamelAppGroup, opts v1.UpdateOptions) (*camelv1alpha1.CamelAppGroup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*camelv1alpha1.CamelAppGroup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*camelv1alpha1.CamelAppGroupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *camelv1alpha1.CamelAppGroup, err error)
+	Apply(ctx context.Context, camelAppGroup *applyconfigurationcamelv1alpha1.CamelAppGroupApplyConfiguration, opts v1.ApplyOptions) (result *camelv1alpha1.CamelAppGroup, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, camelAppGroup *applyconfigurationcamelv1alpha1.CamelAppGroupApplyConfiguration, opts v1.ApplyOptions) (result *camelv1alpha1.CamelAppGroup, err error)
+	CamelAppGroupExpansion
+}
+
+// camelAppGroups implements CamelAppGroupInterface
+type camelAppGroups struct {
+	*gentype.ClientWithListAndApply[*camelv1alpha1.CamelAppGroup, *camelv1alpha1.CamelAppGroupList, *applyconfigurationcamelv1alpha1.CamelAppGroupApplyConfiguration]
+}
+
+// newCamelAppGroups returns a CamelAppGroups
+func newCamelAppGroups(c *CamelV1alpha1Client, namespace string) *camelAppGroups {
+	return &camelAppGroups{
+		gentype.NewClientWithListAndApply[*camelv1alpha1.CamelAppGroup, *camelv1alpha1.CamelAppGroupList, *applyconfigurationcamelv1alpha1.CamelAppGroupApplyConfiguration](
+			"camelappgroups",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *camelv1alpha1.CamelAppGroup { return &camelv1alpha1.CamelAppGroup{} },
+			func() *camelv1alpha1.CamelAppGroupList { return &camelv1alpha1.CamelAppGroupList{} },
+		),
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	applyconfigurationcamelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/applyconfiguration/camel/v1alpha1"
+	scheme "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// CamelDashboardConfigsGetter has a method to return a CamelDashboardConfigInterface.
+// A group's client should implement this interface.
+type CamelDashboardConfigsGetter interface {
+	CamelDashboardConfigs(namespace string) CamelDashboardConfigInterface
+}
+
+// CamelDashboardConfigInterface has methods to work with CamelDashboardConfig resources.
+type CamelDashboardConfigInterface interface {
+	Create(ctx context.Context, camelDashboardConfig *camelv1alpha1.CamelDashboardConfig, opts v1.CreateOptions) (*camelv1alpha1.CamelDashboardConfig, error)
+	Update(ctx context.Context, camelDashboardConfig *camelv1alpha1.CamelDashboardConfig, opts v1.UpdateOptions) (*camelv1alpha1.CamelDashboardConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*camelv1alpha1.CamelDashboardConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*camelv1alpha1.CamelDashboardConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *camelv1alpha1.CamelDashboardConfig, err error)
+	Apply(ctx context.Context, camelDashboardConfig *applyconfigurationcamelv1alpha1.CamelDashboardConfigApplyConfiguration, opts v1.ApplyOptions) (result *camelv1alpha1.CamelDashboardConfig, err error)
+	CamelDashboardConfigExpansion
+}
+
+// camelDashboardConfigs implements CamelDashboardConfigInterface
+type camelDashboardConfigs struct {
+	*gentype.ClientWithListAndApply[*camelv1alpha1.CamelDashboardConfig, *camelv1alpha1.CamelDashboardConfigList, *applyconfigurationcamelv1alpha1.CamelDashboardConfigApplyConfiguration]
+}
+
+// newCamelDashboardConfigs returns a CamelDashboardConfigs
+func newCamelDashboardConfigs(c *CamelV1alpha1Client, namespace string) *camelDashboardConfigs {
+	return &camelDashboardConfigs{
+		gentype.NewClientWithListAndApply[*camelv1alpha1.CamelDashboardConfig, *camelv1alpha1.CamelDashboardConfigList, *applyconfigurationcamelv1alpha1.CamelDashboardConfigApplyConfiguration](
+			"cameldashboardconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *camelv1alpha1.CamelDashboardConfig { return &camelv1alpha1.CamelDashboardConfig{} },
+			func() *camelv1alpha1.CamelDashboardConfigList { return &camelv1alpha1.CamelDashboardConfigList{} },
+		),
+	}
+}
@@ -33,6 +33,18 @@ func (c *FakeCamelV1alpha1) CamelApps(namespace string) v1alpha1.CamelAppInterfa
 	return newFakeCamelApps(c, namespace)
 }
 
+func (c *FakeCamelV1alpha1) CamelAppGroups(namespace string) v1alpha1.CamelAppGroupInterface {
+	return newFakeCamelAppGroups(c, namespace)
+}
+
+func (c *FakeCamelV1alpha1) CamelDashboardConfigs(namespace string) v1alpha1.CamelDashboardConfigInterface {
+	return newFakeCamelDashboardConfigs(c, namespace)
+}
+
+func (c *FakeCamelV1alpha1) CamelFleets(namespace string) v1alpha1.CamelFleetInterface {
+	return newFakeCamelFleets(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCamelV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,54 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/applyconfiguration/camel/v1alpha1"
+	typedcamelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/clientset/versioned/typed/camel/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeCamelDashboardConfigs implements CamelDashboardConfigInterface
+type fakeCamelDashboardConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.CamelDashboardConfig, *v1alpha1.CamelDashboardConfigList, *camelv1alpha1.CamelDashboardConfigApplyConfiguration]
+	Fake *FakeCamelV1alpha1
+}
+
+func newFakeCamelDashboardConfigs(fake *FakeCamelV1alpha1, namespace string) typedcamelv1alpha1.CamelDashboardConfigInterface {
+	return &fakeCamelDashboardConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.CamelDashboardConfig, *v1alpha1.CamelDashboardConfigList, *camelv1alpha1.CamelDashboardConfigApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("cameldashboardconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("CamelDashboardConfig"),
+			func() *v1alpha1.CamelDashboardConfig { return &v1alpha1.CamelDashboardConfig{} },
+			func() *v1alpha1.CamelDashboardConfigList { return &v1alpha1.CamelDashboardConfigList{} },
+			func(dst, src *v1alpha1.CamelDashboardConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.CamelDashboardConfigList) []*v1alpha1.CamelDashboardConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.CamelDashboardConfigList, items []*v1alpha1.CamelDashboardConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/applyconfiguration/camel/v1alpha1"
+	typedcamelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/client/camel/clientset/versioned/typed/camel/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeCamelFleets implements CamelFleetInterface
+type fakeCamelFleets struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.CamelFleet, *v1alpha1.CamelFleetList, *camelv1alpha1.CamelFleetApplyConfiguration]
+	Fake *FakeCamelV1alpha1
+}
+
+func newFakeCamelFleets(fake *FakeCamelV1alpha1, namespace string) typedcamelv1alpha1.CamelFleetInterface {
+	return &fakeCamelFleets{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.CamelFleet, *v1alpha1.CamelFleetList, *camelv1alpha1.CamelFleetApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("camelfleets"),
+			v1alpha1.SchemeGroupVersion.WithKind("CamelFleet"),
+			func() *v1alpha1.CamelFleet { return &v1alpha1.CamelFleet{} },
+			func() *v1alpha1.CamelFleetList { return &v1alpha1.CamelFleetList{} },
+			func(dst, src *v1alpha1.CamelFleetList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.CamelFleetList) []*v1alpha1.CamelFleet { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.CamelFleetList, items []*v1alpha1.CamelFleet) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
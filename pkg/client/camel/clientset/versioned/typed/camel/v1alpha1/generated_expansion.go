@@ -20,3 +20,9 @@ limitations under the License.
 package v1alpha1
 
 type CamelAppExpansion interface{}
+
+type CamelAppGroupExpansion interface{}
+
+type CamelDashboardConfigExpansion interface{}
+
+type CamelFleetExpansion interface{}
@@ -27,6 +27,12 @@ import (
 type Interface interface {
 	// CamelApps returns a CamelAppInformer.
 	CamelApps() CamelAppInformer
+	// CamelAppGroups returns a CamelAppGroupInformer.
+	CamelAppGroups() CamelAppGroupInformer
+	// CamelDashboardConfigs returns a CamelDashboardConfigInformer.
+	CamelDashboardConfigs() CamelDashboardConfigInformer
+	// CamelFleets returns a CamelFleetInformer.
+	CamelFleets() CamelFleetInformer
 }
 
 type version struct {
@@ -44,3 +50,18 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) CamelApps() CamelAppInformer {
 	return &camelAppInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// CamelAppGroups returns a CamelAppGroupInformer.
+func (v *version) CamelAppGroups() CamelAppGroupInformer {
+	return &camelAppGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CamelDashboardConfigs returns a CamelDashboardConfigInformer.
+func (v *version) CamelDashboardConfigs() CamelDashboardConfigInformer {
+	return &camelDashboardConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CamelFleets returns a CamelFleetInformer.
+func (v *version) CamelFleets() CamelFleetInformer {
+	return &camelFleetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
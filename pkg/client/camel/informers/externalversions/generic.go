@@ -56,6 +56,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=camel.apache.org, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("camelapps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1alpha1().CamelApps().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("camelappgroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1alpha1().CamelAppGroups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("cameldashboardconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1alpha1().CamelDashboardConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("camelfleets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Camel().V1alpha1().CamelFleets().Informer()}, nil
 
 	}
 
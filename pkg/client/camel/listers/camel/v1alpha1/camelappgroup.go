@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// CamelAppGroupLister helps list CamelAppGroups.
+// All objects returned here must be treated as read-only.
+type CamelAppGroupLister interface {
+	// List lists all CamelAppGroups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*camelv1alpha1.CamelAppGroup, err error)
+	// CamelAppGroups returns an object that can list and get CamelAppGroups.
+	CamelAppGroups(namespace string) CamelAppGroupNamespaceLister
+	CamelAppGroupListerExpansion
+}
+
+// camelAppGroupLister implements the CamelAppGroupLister interface.
+type camelAppGroupLister struct {
+	listers.ResourceIndexer[*camelv1alpha1.CamelAppGroup]
+}
+
+// NewCamelAppGroupLister returns a new CamelAppGroupLister.
+func NewCamelAppGroupLister(indexer cache.Indexer) CamelAppGroupLister {
+	return &camelAppGroupLister{listers.New[*camelv1alpha1.CamelAppGroup](indexer, camelv1alpha1.Resource("camelappgroup"))}
+}
+
+// CamelAppGroups returns an object that can list and get CamelAppGroups.
+func (s *camelAppGroupLister) CamelAppGroups(namespace string) CamelAppGroupNamespaceLister {
+	return camelAppGroupNamespaceLister{listers.NewNamespaced[*camelv1alpha1.CamelAppGroup](s.ResourceIndexer, namespace)}
+}
+
+// CamelAppGroupNamespaceLister helps list and get CamelAppGroups.
+// All objects returned here must be treated as read-only.
+type CamelAppGroupNamespaceLister interface {
+	// List lists all CamelAppGroups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*camelv1alpha1.CamelAppGroup, err error)
+	// Get retrieves the CamelAppGroup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*camelv1alpha1.CamelAppGroup, error)
+	CamelAppGroupNamespaceListerExpansion
+}
+
+// camelAppGroupNamespaceLister implements the CamelAppGroupNamespaceLister
+// interface.
+type camelAppGroupNamespaceLister struct {
+	listers.ResourceIndexer[*camelv1alpha1.CamelAppGroup]
+}
@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	camelv1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// CamelFleetLister helps list CamelFleets.
+// All objects returned here must be treated as read-only.
+type CamelFleetLister interface {
+	// List lists all CamelFleets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*camelv1alpha1.CamelFleet, err error)
+	// CamelFleets returns an object that can list and get CamelFleets.
+	CamelFleets(namespace string) CamelFleetNamespaceLister
+	CamelFleetListerExpansion
+}
+
+// camelFleetLister implements the CamelFleetLister interface.
+type camelFleetLister struct {
+	listers.ResourceIndexer[*camelv1alpha1.CamelFleet]
+}
+
+// NewCamelFleetLister returns a new CamelFleetLister.
+func NewCamelFleetLister(indexer cache.Indexer) CamelFleetLister {
+	return &camelFleetLister{listers.New[*camelv1alpha1.CamelFleet](indexer, camelv1alpha1.Resource("camelfleet"))}
+}
+
+// CamelFleets returns an object that can list and get CamelFleets.
+func (s *camelFleetLister) CamelFleets(namespace string) CamelFleetNamespaceLister {
+	return camelFleetNamespaceLister{listers.NewNamespaced[*camelv1alpha1.CamelFleet](s.ResourceIndexer, namespace)}
+}
+
+// CamelFleetNamespaceLister helps list and get CamelFleets.
+// All objects returned here must be treated as read-only.
+type CamelFleetNamespaceLister interface {
+	// List lists all CamelFleets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*camelv1alpha1.CamelFleet, err error)
+	// Get retrieves the CamelFleet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*camelv1alpha1.CamelFleet, error)
+	CamelFleetNamespaceListerExpansion
+}
+
+// camelFleetNamespaceLister implements the CamelFleetNamespaceLister
+// interface.
+type camelFleetNamespaceLister struct {
+	listers.ResourceIndexer[*camelv1alpha1.CamelFleet]
+}
@@ -26,3 +26,27 @@ type CamelAppListerExpansion interface{}
 // CamelAppNamespaceListerExpansion allows custom methods to be added to
 // CamelAppNamespaceLister.
 type CamelAppNamespaceListerExpansion interface{}
+
+// CamelAppGroupListerExpansion allows custom methods to be added to
+// CamelAppGroupLister.
+type CamelAppGroupListerExpansion interface{}
+
+// CamelAppGroupNamespaceListerExpansion allows custom methods to be added to
+// CamelAppGroupNamespaceLister.
+type CamelAppGroupNamespaceListerExpansion interface{}
+
+// CamelDashboardConfigListerExpansion allows custom methods to be added to
+// CamelDashboardConfigLister.
+type CamelDashboardConfigListerExpansion interface{}
+
+// CamelDashboardConfigNamespaceListerExpansion allows custom methods to be added to
+// CamelDashboardConfigNamespaceLister.
+type CamelDashboardConfigNamespaceListerExpansion interface{}
+
+// CamelFleetListerExpansion allows custom methods to be added to
+// CamelFleetLister.
+type CamelFleetListerExpansion interface{}
+
+// CamelFleetNamespaceListerExpansion allows custom methods to be added to
+// CamelFleetNamespaceLister.
+type CamelFleetNamespaceListerExpansion interface{}
@@ -18,6 +18,7 @@ limitations under the License.
 package operator
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -33,8 +34,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
@@ -52,12 +54,15 @@ import (
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller"
+	operatorconfig "github.com/camel-tooling/camel-dashboard-operator/pkg/controller/config"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/synthetic"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/restapi"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/defaults"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/kubernetes"
 	logutil "github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/monitoring"
 )
 
 var log = logutil.Log.WithName("cmd")
@@ -119,8 +124,8 @@ func Run(healthPort, monitoringPort int, leaderElection bool, leaderElectionID s
 
 	printVersion()
 
-	watchNamespace, err := getWatchNamespace()
-	exitOnError(err, "failed to get watch namespace")
+	watchNamespaces, err := getWatchNamespaces()
+	exitOnError(err, "failed to get watch namespaces")
 
 	ctx := signals.SetupSignalHandler()
 
@@ -139,7 +144,9 @@ func Run(healthPort, monitoringPort int, leaderElection bool, leaderElectionID s
 		// Fallback to using the watch namespace when the operator is not in-cluster.
 		// It does not support local (off-cluster) operator watching resources globally,
 		// in which case it's not possible to determine a namespace.
-		operatorNamespace = watchNamespace
+		if len(watchNamespaces) > 0 {
+			operatorNamespace = watchNamespaces[0]
+		}
 		if operatorNamespace == "" {
 			leaderElection = false
 			log.Info("unable to determine namespace for leader election")
@@ -150,14 +157,18 @@ func Run(healthPort, monitoringPort int, leaderElection bool, leaderElectionID s
 		log.Info("Leader election is disabled!")
 	}
 
+	exitOnError(operatorconfig.Load(ctx, bootstrapClient), "cannot load the operator configuration")
 	labelsSelector := getLabelSelector()
+	if platform.GetAppImportMode() == platform.ImportModeAnnotation {
+		log.Infof("Importing the labelled Camel applications annotated with %s=true", v1alpha1.AppDashboardImportAnnotation)
+	}
 	selector := cache.ByObject{
 		Label: labelsSelector,
 	}
 	if !platform.IsCurrentOperatorGlobal() {
 		selector = cache.ByObject{
 			Label:      labelsSelector,
-			Namespaces: getNamespacesSelector(operatorNamespace, watchNamespace),
+			Namespaces: getNamespacesSelector(operatorNamespace, watchNamespaces),
 		}
 	}
 	selectors := map[ctrl.Object]cache.ByObject{
@@ -171,11 +182,34 @@ func Run(healthPort, monitoringPort int, leaderElection bool, leaderElectionID s
 		selectors[&batchv1.CronJob{}] = selector
 	}
 
+	namespaceSelector, err := platform.GetMonitorNamespaceSelector()
+	exitOnError(err, "cannot parse the monitor namespace selector")
+	if namespaceSelector != nil {
+		if !platform.IsCurrentOperatorGlobal() {
+			exitOnError(fmt.Errorf("%s and %s are mutually exclusive", platform.OperatorWatchNamespaceEnvVariable,
+				platform.MonitorNamespaceSelectorEnvVariable), "invalid monitor namespace configuration")
+		}
+		// Only the namespaces are filtered by the selector: the applications of the other namespaces are still cached, and
+		// skipped when reconciled.
+		log.Infof("Monitoring the applications of the namespaces matching (%s) label selector", namespaceSelector.String())
+		selectors[&corev1.Namespace{}] = cache.ByObject{
+			Label: namespaceSelector,
+		}
+	}
+
+	if configMap := platform.GetOperatorConfigMap(); configMap != "" && operatorNamespace != "" {
+		// The operator configuration ConfigMap only: the metrics mapping one is read on demand
+		selectors[&corev1.ConfigMap{}] = cache.ByObject{
+			Namespaces: map[string]cache.Config{operatorNamespace: {}},
+			Field:      fields.OneTermEqualSelector("metadata.name", configMap),
+		}
+	}
+
 	options := cache.Options{
 		ByObject: selectors,
 	}
 	if !platform.IsCurrentOperatorGlobal() {
-		options.DefaultNamespaces = getNamespacesSelector(operatorNamespace, watchNamespace)
+		options.DefaultNamespaces = getNamespacesSelector(operatorNamespace, watchNamespaces)
 	}
 
 	mgr, err := manager.New(cfg, manager.Options{
@@ -193,19 +227,29 @@ func Run(healthPort, monitoringPort int, leaderElection bool, leaderElectionID s
 	log.Info("Configuring manager")
 	exitOnError(mgr.AddHealthzCheck("health-probe", healthz.Ping), "Unable add liveness check")
 	exitOnError(apis.AddToScheme(mgr.GetScheme()), "")
+	synthetic.LoadOperatorMetricsMapping(ctx, mgr.GetAPIReader())
 	ctrlClient, err := client.FromManager(mgr)
 	exitOnError(err, "")
 	exitOnError(controller.AddToManager(ctx, mgr, ctrlClient), "")
+	exitOnError(monitoring.AddOTelExport(ctx, mgr), "OpenTelemetry export error")
+	exitOnError(restapi.Add(mgr), "REST API error")
 
-	synthEnvVal, synth := os.LookupEnv("CAMEL_APP_IMPORT")
-	if synth && synthEnvVal == "true" {
+	if platform.IsCamelAppImportEnabled() {
 		log.Info("Starting the Camel App Syntentic manager")
-		exitOnError(synthetic.ManageSyntheticCamelApps(ctx, ctrlClient, mgr.GetCache()), "Camel App Syntentic manager error")
+		exitOnError(synthetic.ManageSyntheticCamelApps(ctx, ctrlClient, mgr.GetCache(),
+			mgr.GetEventRecorderFor("camel-dashboard-synthetic")), "Camel App Syntentic manager error")
+		exitOnError(synthetic.AddResync(mgr, ctrlClient), "Camel App Syntentic resync error")
 	} else {
 		log.Info("Camel App Syntentic manager not configured, skipping")
 	}
 	log.Info("Starting the manager")
-	exitOnError(mgr.Start(ctx), "manager exited non-zero")
+	err = mgr.Start(ctx)
+	if errors.Is(err, operatorconfig.ErrRestartRequired) {
+		// Not a failure: the operator is restarted by its Deployment with the new configuration
+		log.Infof("Stopping the operator: %s", err.Error())
+		return
+	}
+	exitOnError(err, "manager exited non-zero")
 }
 
 func getLabelSelector() labels.Selector {
@@ -214,30 +258,33 @@ func getLabelSelector() labels.Selector {
 	if labelSelector == v1alpha1.AppLabel {
 		log.Infof("NOTE: You can change this setting by adding a variable named %s", platform.CamelAppLabelSelector)
 	}
-	hasAppLabel, err := labels.NewRequirement(labelSelector, selection.Exists, []string{})
+	log.Infof("Using (%s) label to name Camel applications on the cluster.", platform.GetAppNameLabel())
+	labelsSelector, err := platform.GetAppSelector()
 	exitOnError(err, "cannot create App label selector")
-	labelsSelector := labels.NewSelector().Add(*hasAppLabel)
 
 	return labelsSelector
 }
 
-func getNamespacesSelector(operatorNamespace string, watchNamespace string) map[string]cache.Config {
+func getNamespacesSelector(operatorNamespace string, watchNamespaces []string) map[string]cache.Config {
 	namespacesSelector := map[string]cache.Config{
 		operatorNamespace: {},
 	}
-	if operatorNamespace != watchNamespace {
+	for _, watchNamespace := range watchNamespaces {
 		namespacesSelector[watchNamespace] = cache.Config{}
 	}
 	return namespacesSelector
 }
 
-// getWatchNamespace returns the Namespace the operator should be watching for changes.
-func getWatchNamespace() (string, error) {
-	ns, found := os.LookupEnv(platform.OperatorWatchNamespaceEnvVariable)
-	if !found {
-		return "", fmt.Errorf("%s must be set", platform.OperatorWatchNamespaceEnvVariable)
+// getWatchNamespaces returns the Namespaces the operator should be watching for changes. An empty list means all namespaces.
+func getWatchNamespaces() ([]string, error) {
+	if _, found := os.LookupEnv(platform.OperatorWatchNamespaceEnvVariable); !found {
+		return nil, fmt.Errorf("%s must be set", platform.OperatorWatchNamespaceEnvVariable)
+	}
+	watchNamespaces := platform.GetOperatorWatchNamespaces()
+	if len(watchNamespaces) > 0 {
+		log.Infof("Watching namespaces %s", strings.Join(watchNamespaces, ", "))
 	}
-	return ns, nil
+	return watchNamespaces, nil
 }
 
 func exitOnError(err error, msg string) {
@@ -23,6 +23,7 @@ import (
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Action --.
@@ -30,6 +31,9 @@ type Action interface {
 	client.Injectable
 	log.Injectable
 
+	// injects the reader used to read directly from the API server
+	InjectReader(reader ctrl.Reader)
+
 	// a user friendly name for the action
 	Name() string
 
@@ -42,6 +46,8 @@ type Action interface {
 
 type baseAction struct {
 	client client.Client
+	// reader reads directly from the API server, ie, the resources not selected by the cache
+	reader ctrl.Reader
 	L      log.Logger
 }
 
@@ -49,6 +55,10 @@ func (action *baseAction) InjectClient(client client.Client) {
 	action.client = client
 }
 
+func (action *baseAction) InjectReader(reader ctrl.Reader) {
+	action.reader = reader
+}
+
 func (action *baseAction) InjectLogger(log log.Logger) {
 	action.L = log
 }
@@ -19,21 +19,24 @@ package app
 
 import (
 	"context"
-	"strconv"
-	"time"
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/event"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/notification"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/monitoring"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,10 +63,24 @@ func newReconciler(mgr manager.Manager, c client.Client) reconcile.Reconciler {
 }
 
 func add(mgr manager.Manager, r reconcile.Reconciler) error {
-	return builder.ControllerManagedBy(mgr).
+	b := builder.ControllerManagedBy(mgr).
 		Named("app-controller").
 		For(&v1alpha1.CamelApp{}, builder.WithPredicates(UpdateFalsePredicate{})).
-		Complete(r)
+		// The deletion is notified once, from the delete event rather than from the reconciliation, which may be
+		// requeued after the App is gone
+		Watches(&v1alpha1.CamelApp{}, handler.Funcs{
+			DeleteFunc: func(ctx context.Context, e ctrlevent.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				if app, ok := e.Object.(*v1alpha1.CamelApp); ok {
+					notification.EmitAppDeletedCloudEvent(ctx, app)
+				}
+			},
+		})
+	if platform.IsNamespaceSelectorEnabled() {
+		// The applications of a namespace are skipped until the namespace is selected
+		b = b.WatchesRawSource(source.Channel(monitoredNamespaces, handler.EnqueueRequestsFromMapFunc(namespaceAppsFor(mgr.GetClient()))))
+	}
+
+	return b.Complete(r)
 }
 
 // reconcileApp reconciles an App object.
@@ -81,13 +98,20 @@ func (r *reconcileApp) Reconcile(ctx context.Context, request reconcile.Request)
 	var instance v1alpha1.CamelApp
 	if err := r.client.Get(ctx, request.NamespacedName, &instance); err != nil {
 		if k8serrors.IsNotFound(err) {
+			monitoring.DeleteAppMetrics(request.Namespace, request.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
+	if !platform.IsNamespaceMonitored(instance.Namespace) {
+		rlog.Debug("Skipping App as its namespace is not monitored")
+		monitoring.DeleteAppMetrics(request.Namespace, request.Name)
+		return reconcile.Result{}, nil
+	}
 
 	actions := []Action{
 		NewMonitorAction(),
+		NewPrometheusAction(),
 	}
 	var err error
 
@@ -96,6 +120,7 @@ func (r *reconcileApp) Reconcile(ctx context.Context, request reconcile.Request)
 
 	for _, a := range actions {
 		a.InjectClient(r.client)
+		a.InjectReader(r.reader)
 		a.InjectLogger(targetLog)
 
 		if !a.CanHandle(target) {
@@ -111,17 +136,19 @@ func (r *reconcileApp) Reconcile(ctx context.Context, request reconcile.Request)
 			}
 			return reconcile.Result{}, err
 		}
+	}
 
-		if target != nil {
-			if err := r.update(ctx, &instance, target, &targetLog); err != nil {
-				event.NotifyAppError(ctx, r.client, r.recorder, &instance, target, err)
-				return reconcile.Result{}, err
-			}
-		}
-		event.NotifyAppUpdated(ctx, r.client, r.recorder, &instance, target)
+	// The App is updated once all the actions are handled
+	if err := r.update(ctx, &instance, target, &targetLog); err != nil {
+		event.NotifyAppError(ctx, r.client, r.recorder, &instance, target, err)
+		return reconcile.Result{}, err
 	}
+	event.NotifyAppUpdated(ctx, r.client, r.recorder, &instance, target)
+	event.NotifyAppTransitions(ctx, r.client, r.recorder, &instance, target)
+	notification.EmitAppCloudEvents(ctx, &instance, target)
+	monitoring.UpdateAppMetrics(target)
 
-	return reconcile.Result{RequeueAfter: getPollingInterval(target)}, nil
+	return reconcile.Result{RequeueAfter: platform.GetAppSettings(ctx, r.client, target.Namespace, target.Annotations).PollingInterval}, nil
 }
 
 func (r *reconcileApp) update(ctx context.Context, base *v1alpha1.CamelApp, target *v1alpha1.CamelApp, log *log.Logger) error {
@@ -140,51 +167,3 @@ func (r *reconcileApp) update(ctx context.Context, base *v1alpha1.CamelApp, targ
 
 	return nil
 }
-
-func getPollingInterval(target *v1alpha1.CamelApp) time.Duration {
-	defaultPolling := platform.GetPollingInterval()
-	if target.Annotations == nil || target.Annotations[v1alpha1.AppPollingIntervalSecondsAnnotation] == "" {
-		return defaultPolling
-	}
-
-	interval, err := strconv.Atoi(target.Annotations[v1alpha1.AppPollingIntervalSecondsAnnotation])
-	if err == nil {
-		return time.Duration(interval) * time.Second
-	} else {
-		log.Error(err, "could not properly parse polling interval annotation, fallback to default operator value")
-	}
-
-	return defaultPolling
-}
-
-func getSLIExchangeErrorThreshold(target *v1alpha1.CamelApp) int {
-	defaultValue := platform.GetSLIExchangeErrorThreshold()
-	if target.Annotations == nil || target.Annotations[v1alpha1.AppSLIExchangeErrorPercentageAnnotation] == "" {
-		return defaultValue
-	}
-
-	val, err := strconv.Atoi(target.Annotations[v1alpha1.AppSLIExchangeErrorPercentageAnnotation])
-	if err == nil {
-		return val
-	} else {
-		log.Error(err, "could not properly parse SLI error percentage, fallback to default operator value")
-	}
-
-	return defaultValue
-}
-
-func getSLIExchangeWarningThreshold(target *v1alpha1.CamelApp) int {
-	defaultValue := platform.GetSLIExchangeWarningThreshold()
-	if target.Annotations == nil || target.Annotations[v1alpha1.AppSLIExchangeWarningPercentageAnnotation] == "" {
-		return defaultValue
-	}
-
-	val, err := strconv.Atoi(target.Annotations[v1alpha1.AppSLIExchangeWarningPercentageAnnotation])
-	if err == nil {
-		return val
-	} else {
-		log.Error(err, "could not properly parse SLI warning percentage, fallback to default operator value")
-	}
-
-	return defaultValue
-}
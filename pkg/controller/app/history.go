@@ -0,0 +1,64 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// maxHistorySize bounds the history, whatever the configuration, so that the App stays far below the object size limit.
+const maxHistorySize = 500
+
+// newHistorySample returns the sample of the main KPIs of an App status.
+func newHistorySample(status v1alpha1.CamelAppStatus, now time.Time) v1alpha1.HistorySample {
+	sample := v1alpha1.HistorySample{
+		Timestamp: metav1.NewTime(now),
+	}
+	for _, pod := range status.Pods {
+		if pod.Ready {
+			sample.ReadyPods++
+		}
+		if pod.Runtime != nil && pod.Runtime.Exchange != nil {
+			sample.ExchangesTotal += pod.Runtime.Exchange.Total
+			sample.ExchangesFailed += pod.Runtime.Exchange.Failed
+		}
+	}
+	if status.SuccessRate != nil {
+		sample.SLIStatus = status.SuccessRate.Status
+	}
+
+	return sample
+}
+
+// appendHistory returns the history with the sample appended, keeping the last size samples only.
+func appendHistory(history []v1alpha1.HistorySample, sample v1alpha1.HistorySample, size int) []v1alpha1.HistorySample {
+	size = min(size, maxHistorySize)
+	if size <= 0 {
+		return nil
+	}
+	history = append(history, sample)
+	if len(history) > size {
+		history = history[len(history)-size:]
+	}
+
+	return append([]v1alpha1.HistorySample(nil), history...)
+}
@@ -0,0 +1,66 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestNewHistorySample(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	status := v1alpha1.CamelAppStatus{
+		Pods: []v1alpha1.PodInfo{
+			{Ready: true, Runtime: &v1alpha1.RuntimeInfo{Exchange: &v1alpha1.ExchangeInfo{Total: 10, Failed: 1}}},
+			{Ready: false, Runtime: &v1alpha1.RuntimeInfo{Exchange: &v1alpha1.ExchangeInfo{Total: 5, Failed: 2}}},
+			{Ready: true},
+		},
+		SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusWarning},
+	}
+
+	assert.Equal(t, v1alpha1.HistorySample{
+		Timestamp:       metav1.NewTime(now),
+		ExchangesTotal:  15,
+		ExchangesFailed: 3,
+		SLIStatus:       v1alpha1.SLIExchangeStatusWarning,
+		ReadyPods:       2,
+	}, newHistorySample(status, now))
+}
+
+func TestAppendHistory(t *testing.T) {
+	sample := func(total int) v1alpha1.HistorySample {
+		return v1alpha1.HistorySample{ExchangesTotal: total}
+	}
+
+	history := appendHistory(nil, sample(1), 3)
+	assert.Equal(t, []v1alpha1.HistorySample{sample(1)}, history)
+	history = appendHistory(history, sample(2), 3)
+	history = appendHistory(history, sample(3), 3)
+	history = appendHistory(history, sample(4), 3)
+	assert.Equal(t, []v1alpha1.HistorySample{sample(2), sample(3), sample(4)}, history)
+
+	// The history shrinks when the size is reduced
+	assert.Equal(t, []v1alpha1.HistorySample{sample(4), sample(5)}, appendHistory(history, sample(5), 2))
+	assert.Nil(t, appendHistory(history, sample(5), 0))
+	assert.Len(t, appendHistory(make([]v1alpha1.HistorySample, maxHistorySize), sample(1), 1000), maxHistorySize)
+}
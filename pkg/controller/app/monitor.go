@@ -21,15 +21,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
-	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/synthetic"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -52,44 +55,66 @@ func (action *monitorAction) CanHandle(app *v1alpha1.CamelApp) bool {
 
 func (action *monitorAction) Handle(ctx context.Context, app *v1alpha1.CamelApp) (*v1alpha1.CamelApp, error) {
 	action.L.Infof("Monitoring App %s/%s with status %s", app.Namespace, app.Name, app.Status.Phase)
-	objOwner, err := lookupObject(ctx, action.client,
-		app.Annotations[v1alpha1.AppImportedKindLabel], app.Namespace, app.Annotations[v1alpha1.AppImportedNameLabel])
+	nonManagedApp, source, err := action.getNonManagedApp(ctx, app)
 	if err != nil {
 		return nil, err
 	}
-	if objOwner == nil {
-		return nil, fmt.Errorf("deployment %s/%s does not exist", app.Namespace, app.Name)
-	}
-	nonManagedApp, err := synthetic.NonManagedCamelApplicationFactory(*objOwner)
+	// The workloads aggregated into the App, if any
+	aggregated, err := synthetic.GetAggregatedWorkloads(ctx, action.client, app)
 	if err != nil {
 		return nil, err
 	}
 	targetApp := app.DeepCopy()
-	targetApp.Status = v1alpha1.CamelAppStatus{}
+	// The history is kept, even if the monitoring fails
+	targetApp.Status = v1alpha1.CamelAppStatus{History: targetApp.Status.History}
 	targetApp.ImportCamelAnnotations(nonManagedApp.GetAnnotations())
+	settings := platform.GetAppSettings(ctx, action.client, targetApp.Namespace, targetApp.Annotations)
 
-	deployImage := nonManagedApp.GetAppImage()
-	appPhase := nonManagedApp.GetAppPhase()
-	targetApp.Status.Phase = appPhase
-	targetApp.Status.Image = deployImage
-	pods, err := nonManagedApp.GetPods(ctx, action.client)
+	targetApp.Status.Image = nonManagedApp.GetAppImage()
+	source.Phase = nonManagedApp.GetAppPhase()
+	sources := []v1alpha1.SourceInfo{source}
+	pods, err := nonManagedApp.GetPods(ctx, action.client, settings)
 	if err != nil {
 		return targetApp, err
 	}
+	replicas := nonManagedApp.GetReplicas()
+	for _, workload := range aggregated {
+		aggregatedApp, err := synthetic.NonManagedCamelApplicationFactory(workload)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, v1alpha1.SourceInfo{
+			Kind:  synthetic.GetWorkloadKind(workload),
+			Name:  workload.GetName(),
+			Phase: aggregatedApp.GetAppPhase(),
+		})
+		aggregatedPods, err := aggregatedApp.GetPods(ctx, action.client, settings)
+		if err != nil {
+			return targetApp, err
+		}
+		pods = append(pods, aggregatedPods...)
+		replicas = sumReplicas(replicas, aggregatedApp.GetReplicas())
+		if targetApp.Status.Image == "" {
+			targetApp.Status.Image = aggregatedApp.GetAppImage()
+		}
+	}
+	targetApp.Status.Phase = getAggregatedPhase(sources)
+	targetApp.Status.Sources = sources
 	targetApp.Status.Pods = pods
-	targetApp.Status.Replicas = nonManagedApp.GetReplicas()
+	targetApp.Status.Replicas = replicas
 	targetRuntimeInfo := getInfo(pods)
 	if targetRuntimeInfo != nil {
 		targetApp.Status.Info = formatRuntimeInfo(targetRuntimeInfo)
+		targetApp.Status.Routes = targetRuntimeInfo.Routes
 	}
 	appRuntimeInfo := getInfo(app.Status.Pods)
 	if appRuntimeInfo != nil && targetRuntimeInfo != nil {
-		pollingInterval := getPollingInterval(targetApp)
-		sliErrPerc := getSLIExchangeErrorThreshold(targetApp)
-		sliWarnPerc := getSLIExchangeWarningThreshold(targetApp)
-		targetApp.Status.SuccessRate = getSLIExchangeSuccessRate(*appRuntimeInfo, *targetRuntimeInfo, &pollingInterval, sliErrPerc, sliWarnPerc)
+		targetApp.Status.SuccessRate = getSLIExchangeSuccessRate(*appRuntimeInfo, *targetRuntimeInfo, &settings.PollingInterval,
+			settings.SLIExchangeErrorPercentage, settings.SLIExchangeWarningPercentage)
 	}
 
+	targetApp.Status.History = appendHistory(targetApp.Status.History, newHistorySample(targetApp.Status, time.Now()), platform.GetHistorySize())
+
 	message := "Success"
 	if app.Status.Replicas != nil && len(pods) != int(*app.Status.Replicas) {
 		message = fmt.Sprintf("%d out of %d pods available", len(pods), int(*app.Status.Replicas))
@@ -115,7 +140,7 @@ func (action *monitorAction) Handle(ctx context.Context, app *v1alpha1.CamelApp)
 
 	if len(pods) > 0 && allPodsUp(pods) {
 		targetApp.Status.AddCondition(metav1.Condition{
-			Type:               "Healthy",
+			Type:               v1alpha1.AppConditionHealthy,
 			Status:             metav1.ConditionTrue,
 			LastTransitionTime: metav1.NewTime(time.Now()),
 			Reason:             "HealthCheckCompleted",
@@ -123,7 +148,7 @@ func (action *monitorAction) Handle(ctx context.Context, app *v1alpha1.CamelApp)
 		})
 	} else {
 		targetApp.Status.AddCondition(metav1.Condition{
-			Type:               "Healthy",
+			Type:               v1alpha1.AppConditionHealthy,
 			Status:             metav1.ConditionFalse,
 			LastTransitionTime: metav1.NewTime(time.Now()),
 			Reason:             "HealthCheckCompleted",
@@ -131,24 +156,143 @@ func (action *monitorAction) Handle(ctx context.Context, app *v1alpha1.CamelApp)
 		})
 	}
 
+	if routes := targetApp.Status.Routes; routes != nil {
+		if notStarted := routes.Total - routes.Started; notStarted <= 0 {
+			targetApp.Status.AddCondition(metav1.Condition{
+				Type:               v1alpha1.AppConditionRoutesRunning,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now()),
+				Reason:             "RoutesStarted",
+				Message:            fmt.Sprintf("All %d routes are started.", routes.Total),
+			})
+		} else {
+			message := fmt.Sprintf("%d out of %d routes are not started.", notStarted, routes.Total)
+			// The suspended and stopped routes are only told apart when inspected via Jolokia
+			if routes.Suspended+routes.Stopped == notStarted {
+				message = fmt.Sprintf("%d out of %d routes are not started (%d suspended, %d stopped).",
+					notStarted, routes.Total, routes.Suspended, routes.Stopped)
+			}
+			targetApp.Status.AddCondition(metav1.Condition{
+				Type:               v1alpha1.AppConditionRoutesRunning,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(time.Now()),
+				Reason:             "RoutesNotStarted",
+				Message:            message,
+			})
+		}
+	}
+
+	if claimants := synthetic.GetAppClaimants(app.Namespace, app.Name); len(claimants) > 1 {
+		targetApp.Status.AddCondition(metav1.Condition{
+			Type:               v1alpha1.AppConditionConflict,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now()),
+			Reason:             "AppNameConflict",
+			Message:            fmt.Sprintf("The App name is claimed by %s.", strings.Join(claimants, ", ")),
+		})
+	}
+
 	return targetApp, nil
 }
 
-func lookupObject(ctx context.Context, c client.Client, kind, ns string, name string) (*ctrl.Object, error) {
+// getNonManagedApp returns the adapter of the workload backing the App, as imported or declared by the App spec, or
+// else of the Pods matching the App spec selector. It also returns the reference of this source.
+func (action *baseAction) getNonManagedApp(ctx context.Context, app *v1alpha1.CamelApp) (synthetic.NonManagedCamelApplicationAdapter, v1alpha1.SourceInfo, error) {
+	if app.Spec.SourceRef == nil && app.Spec.Selector != nil {
+		source := v1alpha1.SourceInfo{Kind: "Pods", Name: metav1.FormatLabelSelector(app.Spec.Selector)}
+		nonManagedApp, err := synthetic.NewNonManagedCamelPods(ctx, action.client, app)
+		return nonManagedApp, source, err
+	}
+	source := v1alpha1.SourceInfo{
+		Kind: app.Annotations[v1alpha1.AppImportedKindLabel],
+		Name: app.Annotations[v1alpha1.AppImportedNameLabel],
+	}
+	// The cache only holds the workloads selected for import
+	var reader ctrl.Reader = action.client
+	if app.Spec.SourceRef != nil {
+		source = v1alpha1.SourceInfo{Kind: app.Spec.SourceRef.Kind, Name: app.Spec.SourceRef.Name}
+		reader = action.reader
+	}
+	objOwner, err := lookupObject(ctx, reader, source.Kind, app.Namespace, source.Name)
+	if err != nil {
+		return nil, source, err
+	}
+	if objOwner == nil {
+		return nil, source, fmt.Errorf("%s %s/%s does not exist", source.Kind, app.Namespace, source.Name)
+	}
+	nonManagedApp, err := synthetic.NonManagedCamelApplicationFactory(*objOwner)
+
+	return nonManagedApp, source, err
+}
+
+// getAggregatedPhase returns the phase of an App backed by several workloads: in error if any workload is in error,
+// else running if any workload is running, else paused if any workload is paused.
+func getAggregatedPhase(sources []v1alpha1.SourceInfo) v1alpha1.CamelAppPhase {
+	phase := sources[0].Phase
+	if len(sources) == 1 {
+		return phase
+	}
+	phases := map[v1alpha1.CamelAppPhase]bool{}
+	for _, source := range sources {
+		phases[source.Phase] = true
+	}
+	for _, p := range []v1alpha1.CamelAppPhase{v1alpha1.CamelAppPhaseError, v1alpha1.CamelAppPhaseRunning, v1alpha1.CamelAppPhasePaused} {
+		if phases[p] {
+			return p
+		}
+	}
+
+	return phase
+}
+
+// sumReplicas returns the sum of the replicas of several workloads, ignoring the ones without replicas.
+func sumReplicas(replicas, other *int32) *int32 {
+	if replicas == nil || *replicas < 0 {
+		return other
+	}
+	if other == nil || *other < 0 {
+		return replicas
+	}
+
+	return ptr.To(*replicas + *other)
+}
+
+func lookupObject(ctx context.Context, c ctrl.Reader, kind, ns string, name string) (*ctrl.Object, error) {
 	var obj ctrl.Object
 	switch kind {
 	case "Deployment":
 		obj = &appsv1.Deployment{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       kind,
-				APIVersion: corev1.SchemeGroupVersion.String(),
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		}
+	case "CronJob":
+		obj = &batchv1.CronJob{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       kind,
+				APIVersion: batchv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		}
+	case "KnativeService":
+		obj = &servingv1.Service{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Service",
+				APIVersion: servingv1.SchemeGroupVersion.String(),
 			},
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace: ns,
 				Name:      name,
 			},
 		}
-		// TODO more cases
 	default:
 		return nil, fmt.Errorf("cannot manage Camel application of type %s", kind)
 	}
@@ -172,7 +316,7 @@ func getInfo(pods []v1alpha1.PodInfo) *v1alpha1.RuntimeInfo {
 
 	for _, pod := range pods {
 		// Collect runtime information only once
-		if runtimeInfo.RuntimeProvider == "" && pod.Runtime != nil {
+		if runtimeInfo.RuntimeProvider == "" && runtimeInfo.CamelVersion == "" && pod.Runtime != nil {
 			runtimeInfo.RuntimeProvider = pod.Runtime.RuntimeProvider
 			runtimeInfo.RuntimeVersion = pod.Runtime.RuntimeVersion
 			runtimeInfo.CamelVersion = pod.Runtime.CamelVersion
@@ -191,9 +335,19 @@ func getInfo(pods []v1alpha1.PodInfo) *v1alpha1.RuntimeInfo {
 				}
 			}
 		}
+		// Sum all the routes by state
+		if pod.Runtime != nil && pod.Runtime.Routes != nil {
+			if runtimeInfo.Routes == nil {
+				runtimeInfo.Routes = &v1alpha1.RoutesInfo{}
+			}
+			runtimeInfo.Routes.Total += pod.Runtime.Routes.Total
+			runtimeInfo.Routes.Started += pod.Runtime.Routes.Started
+			runtimeInfo.Routes.Suspended += pod.Runtime.Routes.Suspended
+			runtimeInfo.Routes.Stopped += pod.Runtime.Routes.Stopped
+		}
 	}
 
-	if runtimeInfo.RuntimeProvider == "" && runtimeInfo.Exchange.Total == 0 {
+	if runtimeInfo.RuntimeProvider == "" && runtimeInfo.CamelVersion == "" && runtimeInfo.Exchange.Total == 0 {
 		// Likely there was no available metric at all
 		return nil
 	}
@@ -228,6 +382,10 @@ func formatRuntimeInfo(runtimeInfo *v1alpha1.RuntimeInfo) string {
 			runtimeInfo.RuntimeProvider, runtimeInfo.RuntimeVersion, runtimeInfo.CamelVersion,
 		)
 	}
+	// The runtime provider is not known when the information is collected via Jolokia
+	if runtimeInfo.CamelVersion != "" {
+		return fmt.Sprintf("Camel %s", runtimeInfo.CamelVersion)
+	}
 	return ""
 }
 
@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestGetAggregatedPhase(t *testing.T) {
+	assert.Equal(t, v1alpha1.CamelAppPhase("TBD"), getAggregatedPhase([]v1alpha1.SourceInfo{{Phase: "TBD"}}))
+	assert.Equal(t, v1alpha1.CamelAppPhaseRunning, getAggregatedPhase([]v1alpha1.SourceInfo{
+		{Kind: "Deployment", Phase: v1alpha1.CamelAppPhaseRunning},
+		{Kind: "CronJob", Phase: "TBD"},
+	}))
+	assert.Equal(t, v1alpha1.CamelAppPhaseError, getAggregatedPhase([]v1alpha1.SourceInfo{
+		{Kind: "Deployment", Phase: v1alpha1.CamelAppPhaseRunning},
+		{Kind: "Deployment", Phase: v1alpha1.CamelAppPhaseError},
+	}))
+	assert.Equal(t, v1alpha1.CamelAppPhasePaused, getAggregatedPhase([]v1alpha1.SourceInfo{
+		{Kind: "CronJob", Phase: "TBD"},
+		{Kind: "Deployment", Phase: v1alpha1.CamelAppPhasePaused},
+	}))
+}
+
+func TestSumReplicas(t *testing.T) {
+	assert.Equal(t, ptr.To(int32(3)), sumReplicas(ptr.To(int32(1)), ptr.To(int32(2))))
+	assert.Equal(t, ptr.To(int32(1)), sumReplicas(ptr.To(int32(1)), ptr.To(int32(-1))))
+	assert.Equal(t, ptr.To(int32(2)), sumReplicas(ptr.To(int32(-1)), ptr.To(int32(2))))
+	assert.Equal(t, ptr.To(int32(1)), sumReplicas(nil, ptr.To(int32(1))))
+}
@@ -0,0 +1,63 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// monitoredNamespaces receives the namespaces which start matching the monitor namespace selector. Their applications
+// were skipped until then, and must be reconciled again.
+var monitoredNamespaces = make(chan ctrlevent.GenericEvent)
+
+// NotifyNamespaceMonitored requests the reconciliation of the applications of a namespace which is now monitored. It
+// blocks until the request is accepted by the controller, or the context is done.
+func NotifyNamespaceMonitored(ctx context.Context, namespace string) {
+	select {
+	case monitoredNamespaces <- ctrlevent.GenericEvent{Object: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}}:
+	case <-ctx.Done():
+	}
+}
+
+// namespaceAppsFor returns the applications of a namespace.
+func namespaceAppsFor(c ctrl.Reader) func(context.Context, ctrl.Object) []reconcile.Request {
+	return func(ctx context.Context, obj ctrl.Object) []reconcile.Request {
+		var apps v1alpha1.CamelAppList
+		if err := c.List(ctx, &apps, ctrl.InNamespace(obj.GetName())); err != nil {
+			Log.Error(err, "Unable to list the applications of namespace "+obj.GetName())
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(apps.Items))
+		for _, app := range apps.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name},
+			})
+		}
+
+		return requests
+	}
+}
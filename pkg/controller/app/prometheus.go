@@ -0,0 +1,277 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/synthetic"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/kubernetes"
+)
+
+const (
+	// prometheusResourcesCondition reports whether the PodMonitor and PrometheusRule of the App are generated.
+	prometheusResourcesCondition = "PrometheusResources"
+	// appMetricLabel is the label added to the scraped metrics to identify the App.
+	appMetricLabel = "camel_app"
+)
+
+var (
+	podMonitorGVK     = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+	prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+
+	// prometheusOperator discovers the Prometheus Operator resources, which may be installed after the operator started
+	prometheusOperator = &apiDiscovery{interval: 5 * time.Minute}
+
+	// prometheusResourcesGenerated tells, for each App already handled since the operator started, whether its
+	// Prometheus resources are generated, so that they are deleted only once when the generation is disabled.
+	prometheusResourcesGenerated sync.Map
+)
+
+// NewPrometheusAction returns an action that generates the PodMonitor and PrometheusRule of the App, when enabled and
+// the Prometheus Operator is installed.
+func NewPrometheusAction() Action {
+	return &prometheusAction{}
+}
+
+type prometheusAction struct {
+	baseAction
+}
+
+func (action *prometheusAction) Name() string {
+	return "prometheus"
+}
+
+func (action *prometheusAction) CanHandle(app *v1alpha1.CamelApp) bool {
+	installed, err := prometheusOperator.isInstalled(func() (bool, error) {
+		podMonitors, err := kubernetes.IsAPIResourceInstalled(action.client, podMonitorGVK.GroupVersion().String(), podMonitorGVK.Kind)
+		if err != nil || !podMonitors {
+			return false, err
+		}
+		return kubernetes.IsAPIResourceInstalled(action.client, prometheusRuleGVK.GroupVersion().String(), prometheusRuleGVK.Kind)
+	})
+	if err != nil {
+		action.L.Error(err, "Could not discover the Prometheus Operator resources")
+	}
+
+	return installed
+}
+
+// apiDiscovery caches the discovery of optional API resources. Resources found are remembered, resources not found
+// are discovered again once the interval is elapsed, and a failed discovery is retried on the next call.
+type apiDiscovery struct {
+	lock      sync.Mutex
+	interval  time.Duration
+	installed bool
+	checked   time.Time
+}
+
+func (d *apiDiscovery) isInstalled(discover func() (bool, error)) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.installed || time.Since(d.checked) < d.interval {
+		return d.installed, nil
+	}
+	installed, err := discover()
+	if err != nil {
+		return false, err
+	}
+	d.installed = installed
+	d.checked = time.Now()
+
+	return installed, nil
+}
+
+func (action *prometheusAction) Handle(ctx context.Context, app *v1alpha1.CamelApp) (*v1alpha1.CamelApp, error) {
+	key := app.Namespace + "/" + app.Name
+	settings := platform.GetAppSettings(ctx, action.client, app.Namespace, app.Annotations)
+	if !settings.PrometheusResources {
+		if generated, known := prometheusResourcesGenerated.Load(key); !known || generated.(bool) {
+			if err := action.deletePrometheusResources(ctx, app); err != nil {
+				action.L.Error(err, "Could not delete the Prometheus resources of the App")
+				return app, nil
+			}
+			prometheusResourcesGenerated.Store(key, false)
+		}
+		return app, nil
+	}
+
+	nonManagedApp, _, err := action.getNonManagedApp(ctx, app)
+	if err != nil {
+		return app, err
+	}
+	condition := metav1.Condition{
+		Type:               prometheusResourcesCondition,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Now()),
+		Reason:             "Generated",
+		Message:            fmt.Sprintf("PodMonitor and PrometheusRule %s generated.", app.Name),
+	}
+	selector := nonManagedApp.GetPodSelector()
+	if selector == nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Unsupported"
+		condition.Message = "The App is not backed by long running Pods."
+		app.Status.AddCondition(condition)
+		return app, nil
+	}
+
+	totalMetric, failedMetric := synthetic.GetExchangesMetricNames(app.Annotations)
+	applier := action.client.ServerOrClientSideApplier()
+	for _, resource := range []*unstructured.Unstructured{
+		newPodMonitor(app, selector, settings),
+		newPrometheusRule(app, settings, totalMetric, failedMetric),
+	} {
+		if err := applier.Apply(ctx, resource); err != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "GenerationFailed"
+			condition.Message = err.Error()
+			break
+		}
+	}
+	prometheusResourcesGenerated.Store(key, condition.Status == metav1.ConditionTrue)
+	app.Status.AddCondition(condition)
+
+	return app, nil
+}
+
+// deletePrometheusResources deletes the PodMonitor and PrometheusRule of the App, if any.
+func (action *prometheusAction) deletePrometheusResources(ctx context.Context, app *v1alpha1.CamelApp) error {
+	for _, gvk := range []schema.GroupVersionKind{podMonitorGVK, prometheusRuleGVK} {
+		resource := &unstructured.Unstructured{}
+		resource.SetGroupVersionKind(gvk)
+		resource.SetNamespace(app.Namespace)
+		resource.SetName(app.Name)
+		if err := action.client.Delete(ctx, resource); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newPrometheusResource returns a Prometheus Operator resource of the App, owned by the App.
+func newPrometheusResource(app *v1alpha1.CamelApp, gvk schema.GroupVersionKind, spec map[string]interface{}) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	resource.SetGroupVersionKind(gvk)
+	resource.SetNamespace(app.Namespace)
+	resource.SetName(app.Name)
+	resource.SetLabels(map[string]string{v1alpha1.AppLabel: app.Name})
+	resource.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       v1alpha1.AppKind,
+		Name:       app.Name,
+		UID:        app.UID,
+		Controller: ptr.To(true),
+	}})
+
+	return resource
+}
+
+// newPodMonitor returns the PodMonitor scraping the metrics endpoint of the App Pods, as the operator does.
+func newPodMonitor(app *v1alpha1.CamelApp, selector *metav1.LabelSelector, settings platform.AppSettings) *unstructured.Unstructured {
+	endpoint := map[string]interface{}{
+		"targetPort": int64(settings.ObservabilityPort),
+		"path":       "/" + strings.TrimPrefix(settings.MetricsEndpoint, "/"),
+		"interval":   formatSeconds(settings.PollingInterval),
+		"relabelings": []interface{}{
+			map[string]interface{}{
+				"targetLabel": appMetricLabel,
+				"replacement": app.Name,
+			},
+		},
+	}
+	if auth := settings.Auth; auth != nil {
+		secretKey := func(key string) map[string]interface{} {
+			return map[string]interface{}{"name": auth.SecretName, "key": key}
+		}
+		switch auth.Type {
+		case v1alpha1.ScrapeAuthTypeBasic:
+			endpoint["basicAuth"] = map[string]interface{}{
+				"username": secretKey(corev1.BasicAuthUsernameKey),
+				"password": secretKey(corev1.BasicAuthPasswordKey),
+			}
+		case v1alpha1.ScrapeAuthTypeBearer:
+			endpoint["authorization"] = map[string]interface{}{
+				"type":        "Bearer",
+				"credentials": secretKey(synthetic.ScrapeTokenKey),
+			}
+		}
+	}
+	podSelector, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(selector)
+
+	return newPrometheusResource(app, podMonitorGVK, map[string]interface{}{
+		"selector":            podSelector,
+		"podMetricsEndpoints": []interface{}{endpoint},
+	})
+}
+
+// newPrometheusRule returns the PrometheusRule alerting on the App exchange SLI thresholds. Consistently with the SLI
+// status computed by the operator, the failure percentage is in error above the SLI warning percentage, and in
+// warning above the SLI error percentage.
+func newPrometheusRule(app *v1alpha1.CamelApp, settings platform.AppSettings, totalMetric, failedMetric string) *unstructured.Unstructured {
+	selector := fmt.Sprintf(`namespace=%q,%s=%q`, app.Namespace, appMetricLabel, app.Name)
+	window := formatSeconds(5 * settings.PollingInterval)
+	failurePercentage := fmt.Sprintf(`100 * sum(rate(%s{%s}[%s])) / sum(rate(%s{%s}[%s]))`,
+		failedMetric, selector, window, totalMetric, selector, window)
+	alert := func(name, severity string, threshold int) map[string]interface{} {
+		return map[string]interface{}{
+			"alert": name,
+			"expr":  fmt.Sprintf("%s > %d", failurePercentage, threshold),
+			"for":   formatSeconds(2 * settings.PollingInterval),
+			"labels": map[string]interface{}{
+				"severity": severity,
+			},
+			"annotations": map[string]interface{}{
+				"summary": fmt.Sprintf("Camel App %s/%s exchange failures above %d%%", app.Namespace, app.Name, threshold),
+				"description": fmt.Sprintf("{{ $value | printf \"%%.2f\" }}%% of the exchanges of the Camel App %s/%s failed over the last %s.",
+					app.Namespace, app.Name, window),
+			},
+		}
+	}
+
+	return newPrometheusResource(app, prometheusRuleGVK, map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{
+				"name": "camel-app-" + app.Name,
+				"rules": []interface{}{
+					alert("CamelAppExchangesError", "critical", settings.SLIExchangeWarningPercentage),
+					alert("CamelAppExchangesWarning", "warning", settings.SLIExchangeErrorPercentage),
+				},
+			},
+		},
+	})
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
@@ -0,0 +1,123 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func newPrometheusTestSettings() platform.AppSettings {
+	return platform.AppSettings{
+		PollingInterval:              30 * time.Second,
+		SLIExchangeErrorPercentage:   5,
+		SLIExchangeWarningPercentage: 10,
+		ObservabilityPort:            9876,
+		MetricsEndpoint:              "observe/metrics",
+		Auth:                         &v1alpha1.ScrapeAuth{Type: v1alpha1.ScrapeAuthTypeBearer, SecretName: "my-secret"},
+	}
+}
+
+func TestNewPodMonitor(t *testing.T) {
+	app := v1alpha1.NewApp("ns", "my-app")
+	app.UID = types.UID("my-uid")
+	podMonitor := newPodMonitor(&app, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-app"}}, newPrometheusTestSettings())
+
+	assert.Equal(t, podMonitorGVK, podMonitor.GroupVersionKind())
+	assert.Equal(t, "ns", podMonitor.GetNamespace())
+	assert.Equal(t, "my-app", podMonitor.GetName())
+	require.Len(t, podMonitor.GetOwnerReferences(), 1)
+	assert.Equal(t, types.UID("my-uid"), podMonitor.GetOwnerReferences()[0].UID)
+	assert.True(t, *podMonitor.GetOwnerReferences()[0].Controller)
+
+	matchLabels, _, _ := unstructured.NestedStringMap(podMonitor.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{"app": "my-app"}, matchLabels)
+	endpoints, _, _ := unstructured.NestedSlice(podMonitor.Object, "spec", "podMetricsEndpoints")
+	require.Len(t, endpoints, 1)
+	endpoint := endpoints[0].(map[string]interface{})
+	assert.Equal(t, int64(9876), endpoint["targetPort"])
+	assert.Equal(t, "/observe/metrics", endpoint["path"])
+	assert.Equal(t, "30s", endpoint["interval"])
+	credentials, _, _ := unstructured.NestedStringMap(endpoint, "authorization", "credentials")
+	assert.Equal(t, map[string]string{"name": "my-secret", "key": "token"}, credentials)
+}
+
+func TestNewPrometheusRule(t *testing.T) {
+	app := v1alpha1.NewApp("ns", "my-app")
+	rule := newPrometheusRule(&app, newPrometheusTestSettings(), "camel_exchanges_total", "camel_exchanges_failed_total")
+
+	assert.Equal(t, prometheusRuleGVK, rule.GroupVersionKind())
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	require.Len(t, groups, 1)
+	rules := groups[0].(map[string]interface{})["rules"].([]interface{})
+	require.Len(t, rules, 2)
+
+	errorRule := rules[0].(map[string]interface{})
+	assert.Equal(t, "CamelAppExchangesError", errorRule["alert"])
+	assert.Equal(t, `100 * sum(rate(camel_exchanges_failed_total{namespace="ns",camel_app="my-app"}[150s])) / `+
+		`sum(rate(camel_exchanges_total{namespace="ns",camel_app="my-app"}[150s])) > 10`, errorRule["expr"])
+	assert.Equal(t, "60s", errorRule["for"])
+	assert.Equal(t, "critical", errorRule["labels"].(map[string]interface{})["severity"])
+
+	warningRule := rules[1].(map[string]interface{})
+	assert.Equal(t, "CamelAppExchangesWarning", warningRule["alert"])
+	assert.Contains(t, warningRule["expr"], "> 5")
+	assert.Equal(t, "warning", warningRule["labels"].(map[string]interface{})["severity"])
+}
+
+func TestAPIDiscovery(t *testing.T) {
+	discovery := &apiDiscovery{interval: time.Hour}
+	calls := 0
+	discover := func(installed bool, err error) func() (bool, error) {
+		return func() (bool, error) {
+			calls++
+			return installed, err
+		}
+	}
+
+	_, err := discovery.isInstalled(discover(false, errors.New("discovery failed")))
+	require.Error(t, err)
+	// A failed discovery is retried
+	installed, err := discovery.isInstalled(discover(false, nil))
+	require.NoError(t, err)
+	assert.False(t, installed)
+	assert.Equal(t, 2, calls)
+	// A negative discovery is cached until the interval is elapsed
+	installed, _ = discovery.isInstalled(discover(true, nil))
+	assert.False(t, installed)
+	assert.Equal(t, 2, calls)
+	discovery.checked = time.Now().Add(-2 * time.Hour)
+	installed, _ = discovery.isInstalled(discover(true, nil))
+	assert.True(t, installed)
+	assert.Equal(t, 3, calls)
+	// A positive discovery is definitive
+	discovery.checked = time.Now().Add(-2 * time.Hour)
+	installed, _ = discovery.isInstalled(discover(false, nil))
+	assert.True(t, installed)
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,207 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appgroup
+
+import (
+	"context"
+	"slices"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/event"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Add creates the controller maintaining the CamelAppGroup statuses. A group is updated when the labels, phase, health
+// or SLI status of one of its Apps changes. The combined exchange success rate, which changes at every monitoring cycle
+// of the Apps, is refreshed at the operator polling interval instead.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	r := &reconcileAppGroup{
+		client:   c,
+		recorder: mgr.GetEventRecorderFor("camel-dashboard-appgroup-controller"),
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("appgroup-controller").
+		For(&v1alpha1.CamelAppGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.CamelApp{},
+			handler.EnqueueRequestsFromMapFunc(r.groupsFor),
+			builder.WithPredicates(appMemberChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// reconcileAppGroup reconciles a CamelAppGroup object.
+type reconcileAppGroup struct {
+	client   ctrl.Client
+	recorder record.EventRecorder
+}
+
+func (r *reconcileAppGroup) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	var group v1alpha1.CamelAppGroup
+	if err := r.client.Get(ctx, request.NamespacedName, &group); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		rlog.Error(err, "Invalid group selector")
+		r.recorder.Eventf(&group, corev1.EventTypeWarning, "InvalidSelector", "Invalid group selector: %v", err)
+		return reconcile.Result{}, nil
+	}
+	namespaces := getGroupNamespaces(&group)
+	if len(group.Spec.Namespaces) > 0 && !isOperatorNamespace(group.Namespace) && group.Generation != group.Status.ObservedGeneration {
+		r.recorder.Eventf(&group, corev1.EventTypeWarning, "NamespacesIgnored",
+			"Only a group of the operator namespace can select the Apps of other namespaces, selecting the Apps of namespace %s", group.Namespace)
+	}
+
+	var apps []v1alpha1.CamelApp
+	for _, namespace := range namespaces {
+		if !platform.IsNamespaceMonitored(namespace) {
+			continue
+		}
+		var list v1alpha1.CamelAppList
+		if err := r.client.List(ctx, &list, ctrl.InNamespace(namespace), ctrl.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return reconcile.Result{}, err
+		}
+		apps = append(apps, list.Items...)
+	}
+
+	settings := platform.GetAppSettings(ctx, r.client, group.Namespace, nil)
+	target := group.DeepCopy()
+	target.Status = newGroupStatus(apps,
+		ptr.Deref(group.Spec.SLIExchangeErrorPercentage, settings.SLIExchangeErrorPercentage),
+		ptr.Deref(group.Spec.SLIExchangeWarningPercentage, settings.SLIExchangeWarningPercentage))
+	target.Status.ObservedGeneration = group.Generation
+	result := reconcile.Result{}
+	if target.Status.SuccessRate != nil {
+		result.RequeueAfter = platform.GetPollingInterval()
+	}
+	if equality.Semantic.DeepEqual(target.Status, group.Status) {
+		return result, nil
+	}
+
+	if err := r.client.Status().Patch(ctx, target, ctrl.MergeFrom(&group)); err != nil {
+		return reconcile.Result{}, err
+	}
+	event.NotifyAppGroupTransitions(r.recorder, &group, target)
+	if target.Status.Health != group.Status.Health {
+		rlog.Info(
+			"Health transition",
+			"health-from", group.Status.Health,
+			"health-to", target.Status.Health,
+		)
+	}
+
+	return result, nil
+}
+
+// groupsFor returns the requests of the groups selecting the given App, or listing it as a member.
+func (r *reconcileAppGroup) groupsFor(ctx context.Context, obj ctrl.Object) []reconcile.Request {
+	var groups v1alpha1.CamelAppGroupList
+	if err := r.client.List(ctx, &groups); err != nil {
+		Log.Error(err, "Unable to list the groups")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, group := range groups.Items {
+		if isMember(&group, obj) || selects(&group, obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: group.Namespace, Name: group.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+func isMember(group *v1alpha1.CamelAppGroup, obj ctrl.Object) bool {
+	return slices.ContainsFunc(group.Status.Members, func(member v1alpha1.AppGroupMember) bool {
+		return member.Namespace == obj.GetNamespace() && member.Name == obj.GetName()
+	})
+}
+
+func selects(group *v1alpha1.CamelAppGroup, obj ctrl.Object) bool {
+	if !slices.Contains(getGroupNamespaces(group), obj.GetNamespace()) {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// getGroupNamespaces returns the namespaces of the Apps of the group.
+func getGroupNamespaces(group *v1alpha1.CamelAppGroup) []string {
+	if len(group.Spec.Namespaces) == 0 || !isOperatorNamespace(group.Namespace) {
+		return []string{group.Namespace}
+	}
+	return group.Spec.Namespaces
+}
+
+func isOperatorNamespace(namespace string) bool {
+	operatorNamespace := platform.GetOperatorNamespace()
+	return operatorNamespace != "" && namespace == operatorNamespace
+}
+
+// appMemberChangedPredicate filters out the App updates which change neither its labels, nor its phase, health or SLI
+// status, such as the ones of every monitoring cycle.
+type appMemberChangedPredicate struct {
+	predicate.Funcs
+}
+
+func (appMemberChangedPredicate) Update(e ctrlevent.UpdateEvent) bool {
+	oldApp, ok := e.ObjectOld.(*v1alpha1.CamelApp)
+	if !ok {
+		return false
+	}
+	newApp, ok := e.ObjectNew.(*v1alpha1.CamelApp)
+	if !ok {
+		return false
+	}
+	oldMember, newMember := newGroupMember(oldApp), newGroupMember(newApp)
+	return !equality.Semantic.DeepEqual(oldApp.Labels, newApp.Labels) ||
+		oldMember.Phase != newMember.Phase ||
+		oldMember.Healthy != newMember.Healthy ||
+		oldMember.SLIStatus != newMember.SLIStatus
+}
+
+func (appMemberChangedPredicate) Generic(e ctrlevent.GenericEvent) bool {
+	return false
+}
@@ -0,0 +1,203 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestNewGroupStatus(t *testing.T) {
+	assert.Equal(t, v1alpha1.CamelAppGroupStatus{}, newGroupStatus(nil, 5, 10))
+
+	healthy := []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionTrue}}
+	unhealthy := []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionFalse}}
+	status := newGroupStatus([]v1alpha1.CamelApp{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "b"},
+			Status: v1alpha1.CamelAppStatus{
+				Conditions:  healthy,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SamplingIntervalTotal: 100, SamplingIntervalFailed: 2},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "a"},
+			Status:     v1alpha1.CamelAppStatus{Conditions: healthy},
+		},
+	}, 5, 10)
+	assert.Equal(t, 2, status.Apps)
+	assert.Equal(t, 2, status.HealthyApps)
+	assert.Equal(t, v1alpha1.CamelAppGroupHealthHealthy, status.Health)
+	assert.Equal(t, "ns1", status.Members[0].Namespace)
+	assert.Equal(t, "98.00", status.SuccessRate.SuccessPercentage)
+	assert.Equal(t, v1alpha1.SLIExchangeStatusSuccess, status.SuccessRate.Status)
+
+	// The failures of a member are combined with the exchanges of the whole group
+	failing := v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "b"},
+		Status: v1alpha1.CamelAppStatus{
+			Conditions:  unhealthy,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SamplingIntervalTotal: 100, SamplingIntervalFailed: 14},
+		},
+	}
+	status = newGroupStatus([]v1alpha1.CamelApp{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "a"},
+			Status: v1alpha1.CamelAppStatus{
+				Conditions:  healthy,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SamplingIntervalTotal: 100},
+			},
+		},
+		failing,
+	}, 5, 10)
+	assert.Equal(t, v1alpha1.CamelAppGroupHealthDegraded, status.Health)
+	assert.Equal(t, 200, status.SuccessRate.SamplingIntervalTotal)
+	assert.Equal(t, "93.00", status.SuccessRate.SuccessPercentage)
+	assert.Equal(t, v1alpha1.SLIExchangeStatusWarning, status.SuccessRate.Status)
+
+	status = newGroupStatus([]v1alpha1.CamelApp{failing}, 5, 10)
+	assert.Equal(t, v1alpha1.CamelAppGroupHealthUnhealthy, status.Health)
+	assert.Equal(t, v1alpha1.SLIExchangeStatusError, status.SuccessRate.Status)
+}
+
+func TestReconcileAppGroup(t *testing.T) {
+	t.Setenv("NAMESPACE", "operator")
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	spec := v1alpha1.CamelAppGroupSpec{
+		Selector:   metav1.LabelSelector{MatchLabels: map[string]string{"system": "orders"}},
+		Namespaces: []string{"ns1", "ns2"},
+	}
+	group := &v1alpha1.CamelAppGroup{ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "orders"}, Spec: spec}
+	nsGroup := &v1alpha1.CamelAppGroup{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "orders", Generation: 1}, Spec: spec}
+	labels := map[string]string{"system": "orders"}
+	healthy := []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionTrue}}
+	app := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "a", Labels: labels},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:       v1alpha1.CamelAppPhaseRunning,
+			Conditions:  healthy,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SamplingIntervalTotal: 100},
+		},
+	}
+	other := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "other"},
+		Status:     v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhaseRunning, Conditions: healthy},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha1.CamelAppGroup{}).
+		WithObjects(group, nsGroup, app, other,
+			&v1alpha1.CamelApp{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "b", Labels: labels},
+				Status: v1alpha1.CamelAppStatus{
+					Phase:       v1alpha1.CamelAppPhaseRunning,
+					Conditions:  []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionFalse}},
+					SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SamplingIntervalTotal: 100, SamplingIntervalFailed: 50},
+				},
+			},
+			&v1alpha1.CamelApp{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns3", Name: "c", Labels: labels},
+				Status:     v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhaseRunning, Conditions: healthy},
+			},
+		).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &reconcileAppGroup{client: c, recorder: recorder}
+
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "operator", Name: "orders"}},
+		{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "orders"}},
+	}, r.groupsFor(context.Background(), app))
+	assert.Empty(t, r.groupsFor(context.Background(), other))
+
+	for _, g := range []*v1alpha1.CamelAppGroup{group, nsGroup} {
+		result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: g.Namespace, Name: g.Name}})
+		require.NoError(t, err)
+		// The combined success rate is refreshed at the polling interval
+		assert.Equal(t, platform.GetPollingInterval(), result.RequeueAfter)
+	}
+
+	var updated v1alpha1.CamelAppGroup
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "operator", Name: "orders"}, &updated))
+	assert.Equal(t, 2, updated.Status.Apps)
+	assert.Equal(t, 1, updated.Status.HealthyApps)
+	assert.Equal(t, v1alpha1.CamelAppGroupHealthDegraded, updated.Status.Health)
+	assert.Equal(t, "75.00", updated.Status.SuccessRate.SuccessPercentage)
+	assert.Equal(t, v1alpha1.SLIExchangeStatusError, updated.Status.SuccessRate.Status)
+
+	// A group of another namespace only selects the Apps of its own namespace
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "ns1", Name: "orders"}, &updated))
+	assert.Equal(t, 1, updated.Status.Apps)
+	select {
+	case evt := <-recorder.Events:
+		assert.Contains(t, evt, "NamespacesIgnored")
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected a NamespacesIgnored event")
+	}
+
+	// A removed member still requests its former groups
+	app.Labels = nil
+	assert.Len(t, r.groupsFor(context.Background(), app), 2)
+}
+
+func TestAppMemberChangedPredicate(t *testing.T) {
+	p := appMemberChangedPredicate{}
+	app := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app", Labels: map[string]string{"system": "orders"}},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:      v1alpha1.CamelAppPhaseRunning,
+			Conditions: []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionTrue}},
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{
+				SamplingIntervalTotal: 100, Status: v1alpha1.SLIExchangeStatusSuccess, SuccessPercentage: "100.00",
+			},
+		},
+	}
+
+	polled := app.DeepCopy()
+	polled.Status.SuccessRate.SamplingIntervalTotal = 120
+	polled.Status.SuccessRate.SamplingIntervalFailed = 1
+	polled.Status.SuccessRate.SuccessPercentage = "99.17"
+	polled.Status.SuccessRate.LastTimestamp = &metav1.Time{Time: time.Now()}
+	assert.False(t, p.Update(ctrlevent.UpdateEvent{ObjectOld: app, ObjectNew: polled}))
+
+	degraded := app.DeepCopy()
+	degraded.Status.SuccessRate.Status = v1alpha1.SLIExchangeStatusWarning
+	assert.True(t, p.Update(ctrlevent.UpdateEvent{ObjectOld: app, ObjectNew: degraded}))
+
+	unhealthy := app.DeepCopy()
+	unhealthy.Status.Conditions[0].Status = metav1.ConditionFalse
+	assert.True(t, p.Update(ctrlevent.UpdateEvent{ObjectOld: app, ObjectNew: unhealthy}))
+
+	relabeled := app.DeepCopy()
+	relabeled.Labels = nil
+	assert.True(t, p.Update(ctrlevent.UpdateEvent{ObjectOld: app, ObjectNew: relabeled}))
+}
@@ -0,0 +1,116 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appgroup
+
+import (
+	"sort"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// newGroupStatus aggregates the health and the exchange success rate of the given Apps in a group status.
+func newGroupStatus(apps []v1alpha1.CamelApp, sliErrPerc, sliWarnPerc int) v1alpha1.CamelAppGroupStatus {
+	status := v1alpha1.CamelAppGroupStatus{
+		Apps: len(apps),
+	}
+	if len(apps) == 0 {
+		return status
+	}
+
+	var rate *v1alpha1.SLIExchangeSuccessRate
+	for i := range apps {
+		member := newGroupMember(&apps[i])
+		if member.Healthy {
+			status.HealthyApps++
+		}
+		status.Members = append(status.Members, member)
+
+		appRate := apps[i].Status.SuccessRate
+		if appRate == nil {
+			continue
+		}
+		if rate == nil {
+			rate = &v1alpha1.SLIExchangeSuccessRate{}
+		}
+		rate.SamplingIntervalTotal += appRate.SamplingIntervalTotal
+		rate.SamplingIntervalFailed += appRate.SamplingIntervalFailed
+		if appRate.LastTimestamp != nil && (rate.LastTimestamp == nil || appRate.LastTimestamp.After(rate.LastTimestamp.Time)) {
+			rate.LastTimestamp = appRate.LastTimestamp.DeepCopy()
+		}
+	}
+	sort.Slice(status.Members, func(i, j int) bool {
+		if status.Members[i].Namespace != status.Members[j].Namespace {
+			return status.Members[i].Namespace < status.Members[j].Namespace
+		}
+		return status.Members[i].Name < status.Members[j].Name
+	})
+
+	switch status.HealthyApps {
+	case status.Apps:
+		status.Health = v1alpha1.CamelAppGroupHealthHealthy
+	case 0:
+		status.Health = v1alpha1.CamelAppGroupHealthUnhealthy
+	default:
+		status.Health = v1alpha1.CamelAppGroupHealthDegraded
+	}
+
+	if rate != nil {
+		setSLIStatus(rate, sliErrPerc, sliWarnPerc)
+		status.SuccessRate = rate
+	}
+
+	return status
+}
+
+// setSLIStatus computes the success percentage and the SLI status of the combined exchanges, the same way the ones of
+// a single App are.
+func setSLIStatus(rate *v1alpha1.SLIExchangeSuccessRate, sliErrPerc, sliWarnPerc int) {
+	if rate.SamplingIntervalTotal <= 0 {
+		return
+	}
+	failureRate := float64(rate.SamplingIntervalFailed) / float64(rate.SamplingIntervalTotal) * 100
+	rate.SuccessPercentage = strconv.FormatFloat(100-failureRate, 'f', 2, 64)
+	switch {
+	case failureRate > float64(sliWarnPerc):
+		rate.Status = v1alpha1.SLIExchangeStatusError
+	case failureRate > float64(sliErrPerc):
+		rate.Status = v1alpha1.SLIExchangeStatusWarning
+	default:
+		rate.Status = v1alpha1.SLIExchangeStatusSuccess
+	}
+}
+
+// newGroupMember returns the group view of the given App.
+func newGroupMember(app *v1alpha1.CamelApp) v1alpha1.AppGroupMember {
+	member := v1alpha1.AppGroupMember{
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Phase:     app.Status.Phase,
+	}
+	if healthy := app.Status.GetCondition(v1alpha1.AppConditionHealthy); healthy != nil {
+		member.Healthy = healthy.Status == metav1.ConditionTrue
+	}
+	if rate := app.Status.SuccessRate; rate != nil {
+		member.SLIStatus = rate.Status
+		member.SuccessPercentage = rate.SuccessPercentage
+	}
+	return member
+}
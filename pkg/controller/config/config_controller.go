@@ -0,0 +1,169 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/synthetic"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ErrRestartRequired is returned by the manager when the operator must be restarted to apply its configuration. It is not
+// a failure: the operator is expected to exit successfully and be restarted by its Deployment.
+var ErrRestartRequired = errors.New("operator restart required")
+
+// Add creates the controller watching the operator ConfigMap, if any, and applying its settings live.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	name := platform.GetOperatorConfigMap()
+	namespace := platform.GetOperatorNamespace()
+	if name == "" || namespace == "" {
+		Log.Info("Operator configuration ConfigMap not configured, skipping")
+		return nil
+	}
+
+	r := &reconcileConfig{
+		client:        c,
+		reader:        mgr.GetAPIReader(),
+		recorder:      mgr.GetEventRecorderFor("camel-dashboard-config-controller"),
+		labelSelector: appSelector(),
+		restart:       make(chan string, 1),
+	}
+	// The label selector is used to configure the cache of the manager, which can't be changed once started:
+	// the manager is stopped in order to let the operator restart with the new settings.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return nil
+		case reason := <-r.restart:
+			return fmt.Errorf("%w: %s", ErrRestartRequired, reason)
+		}
+	})); err != nil {
+		return err
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("config-controller").
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj ctrl.Object) bool {
+			return obj.GetNamespace() == namespace && obj.GetName() == name
+		}))).
+		Complete(r)
+}
+
+// Load applies the settings of the operator ConfigMap, if any. It is meant to be called before the manager is created.
+func Load(ctx context.Context, c client.Client) error {
+	name := platform.GetOperatorConfigMap()
+	namespace := platform.GetOperatorNamespace()
+	if name == "" || namespace == "" {
+		return nil
+	}
+	cm, err := c.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			Log.Infof("Operator configuration ConfigMap %s/%s not found, using the default configuration", namespace, name)
+			return nil
+		}
+		return err
+	}
+	config, errs := platform.ValidateOperatorConfig(cm.Data)
+	for _, err := range errs {
+		Log.Error(err, "Ignoring operator configuration setting")
+	}
+	platform.SetOperatorConfig(config)
+
+	return nil
+}
+
+// reconcileConfig reconciles the operator ConfigMap.
+type reconcileConfig struct {
+	client client.Client
+	// reader reads the metrics mapping ConfigMap, which is not cached
+	reader   ctrl.Reader
+	recorder record.EventRecorder
+	// labelSelector is the label selector in use by the manager cache
+	labelSelector string
+	restart       chan string
+}
+
+func (r *reconcileConfig) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	var cm corev1.ConfigMap
+	if err := r.client.Get(ctx, request.NamespacedName, &cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			rlog.Info("Operator configuration ConfigMap deleted, reverting to the default configuration")
+			platform.SetOperatorConfig(nil)
+			synthetic.LoadOperatorMetricsMapping(ctx, r.reader)
+			r.checkLabelSelector(&rlog, nil)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	config, errs := platform.ValidateOperatorConfig(cm.Data)
+	for _, err := range errs {
+		rlog.Error(err, "Ignoring operator configuration setting")
+		r.recorder.Eventf(&cm, corev1.EventTypeWarning, "InvalidConfiguration", "Ignoring operator configuration: %v", err)
+	}
+	platform.SetOperatorConfig(config)
+	// The metrics mapping ConfigMap may have been renamed
+	synthetic.LoadOperatorMetricsMapping(ctx, r.reader)
+	rlog.Infof("Applied %d operator configuration settings", len(config))
+	r.recorder.Eventf(&cm, corev1.EventTypeNormal, "ConfigurationApplied", "Applied %d operator configuration settings", len(config))
+	r.checkLabelSelector(&rlog, &cm)
+
+	return reconcile.Result{}, nil
+}
+
+// checkLabelSelector requests an operator restart when the label selector in use differs from the configured one.
+func (r *reconcileConfig) checkLabelSelector(rlog *log.Logger, cm *corev1.ConfigMap) {
+	labelSelector := appSelector()
+	if labelSelector == r.labelSelector {
+		return
+	}
+	reason := fmt.Sprintf("label selector changed from %s to %s", r.labelSelector, labelSelector)
+	rlog.Info("Restarting the operator to apply the configuration: " + reason)
+	if cm != nil {
+		r.recorder.Eventf(cm, corev1.EventTypeNormal, "OperatorRestarting", "Restarting the operator to apply the configuration: %s", reason)
+	}
+	select {
+	case r.restart <- reason:
+	default:
+	}
+}
+
+// appSelector returns the label selector identifying the Camel applications, as used by the manager cache.
+func appSelector() string {
+	selector, err := platform.GetAppSelector()
+	if err != nil {
+		return ""
+	}
+	return selector.String()
+}
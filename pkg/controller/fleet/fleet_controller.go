@@ -0,0 +1,166 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Add creates the controller maintaining the CamelFleet summaries. A summary is updated when the phase, SLI status or
+// runtime of one of the Camel applications it covers changes. The success percentages of the worst applications, which
+// change at every monitoring cycle, are refreshed at the operator polling interval instead.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	r := &reconcileFleet{
+		client: c,
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("fleet-controller").
+		For(&v1alpha1.CamelFleet{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1alpha1.CamelApp{},
+			handler.EnqueueRequestsFromMapFunc(r.fleetsFor),
+			builder.WithPredicates(appSummaryChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// reconcileFleet reconciles a CamelFleet object.
+type reconcileFleet struct {
+	client ctrl.Client
+}
+
+func (r *reconcileFleet) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-namespace", request.Namespace, "request-name", request.Name)
+	var fleet v1alpha1.CamelFleet
+	if err := r.client.Get(ctx, request.NamespacedName, &fleet); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	var apps v1alpha1.CamelAppList
+	var opts []ctrl.ListOption
+	if !isGlobalFleet(fleet.Namespace) {
+		opts = append(opts, ctrl.InNamespace(fleet.Namespace))
+	}
+	if err := r.client.List(ctx, &apps, opts...); err != nil {
+		return reconcile.Result{}, err
+	}
+	monitored := make([]v1alpha1.CamelApp, 0, len(apps.Items))
+	for _, app := range apps.Items {
+		if platform.IsNamespaceMonitored(app.Namespace) {
+			monitored = append(monitored, app)
+		}
+	}
+
+	status := Summarize(monitored, ptr.Deref(fleet.Spec.WorstApps, DefaultWorstApps))
+	status.ObservedGeneration = fleet.Generation
+	status.LastUpdateTime = fleet.Status.LastUpdateTime
+	result := reconcile.Result{}
+	if len(status.WorstApps) > 0 {
+		result.RequeueAfter = platform.GetPollingInterval()
+	}
+	if equality.Semantic.DeepEqual(status, fleet.Status) {
+		return result, nil
+	}
+	status.LastUpdateTime = ptr.To(metav1.Now())
+
+	target := fleet.DeepCopy()
+	target.Status = status
+	if err := r.client.Status().Patch(ctx, target, ctrl.MergeFrom(&fleet)); err != nil {
+		return reconcile.Result{}, err
+	}
+	rlog.Debugf("Fleet summary updated with %d applications", status.Apps)
+
+	return result, nil
+}
+
+// fleetsFor returns the requests of the fleets covering the given application: the fleets of its namespace and the
+// ones of the operator namespace.
+func (r *reconcileFleet) fleetsFor(ctx context.Context, obj ctrl.Object) []reconcile.Request {
+	namespaces := []string{obj.GetNamespace()}
+	if operatorNamespace := platform.GetOperatorNamespace(); operatorNamespace != "" && operatorNamespace != obj.GetNamespace() {
+		namespaces = append(namespaces, operatorNamespace)
+	}
+
+	var requests []reconcile.Request
+	for _, namespace := range namespaces {
+		var fleets v1alpha1.CamelFleetList
+		if err := r.client.List(ctx, &fleets, ctrl.InNamespace(namespace)); err != nil {
+			Log.Error(err, "Unable to list the fleets of namespace "+namespace)
+			continue
+		}
+		for _, fleet := range fleets.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: fleet.Namespace, Name: fleet.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+// isGlobalFleet returns true when a fleet of the given namespace covers all the applications monitored by the operator.
+func isGlobalFleet(namespace string) bool {
+	operatorNamespace := platform.GetOperatorNamespace()
+	return operatorNamespace != "" && namespace == operatorNamespace
+}
+
+// appSummaryChangedPredicate filters out the application updates which do not change the phase, SLI status or runtime
+// of the application, such as the ones of every monitoring cycle.
+type appSummaryChangedPredicate struct {
+	predicate.Funcs
+}
+
+func (appSummaryChangedPredicate) Update(e event.UpdateEvent) bool {
+	oldApp, ok := e.ObjectOld.(*v1alpha1.CamelApp)
+	if !ok {
+		return false
+	}
+	newApp, ok := e.ObjectNew.(*v1alpha1.CamelApp)
+	if !ok {
+		return false
+	}
+	if oldApp.Status.Phase != newApp.Status.Phase || getSLIStatus(oldApp) != getSLIStatus(newApp) {
+		return true
+	}
+	oldProvider, oldVersion := getRuntime(oldApp)
+	newProvider, newVersion := getRuntime(newApp)
+	return oldProvider != newProvider || oldVersion != newVersion
+}
+
+func (appSummaryChangedPredicate) Generic(e event.GenericEvent) bool {
+	return false
+}
@@ -0,0 +1,128 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestReconcileFleet(t *testing.T) {
+	t.Setenv("NAMESPACE", "operator")
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	nsFleet := &v1alpha1.CamelFleet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "fleet"}}
+	globalFleet := &v1alpha1.CamelFleet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "fleet"},
+		Spec:       v1alpha1.CamelFleetSpec{WorstApps: ptr.To(1)},
+	}
+	app := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "app"},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:       v1alpha1.CamelAppPhaseRunning,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "80.00"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha1.CamelFleet{}).
+		WithObjects(nsFleet, globalFleet,
+			app,
+			&v1alpha1.CamelApp{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "app"},
+				Status: v1alpha1.CamelAppStatus{
+					Phase:       v1alpha1.CamelAppPhaseRunning,
+					SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusWarning, SuccessPercentage: "96.00"},
+				},
+			},
+		).
+		Build()
+	r := &reconcileFleet{client: c}
+
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "fleet"}},
+		{NamespacedName: types.NamespacedName{Namespace: "operator", Name: "fleet"}},
+	}, r.fleetsFor(context.Background(), app))
+
+	for _, fleet := range []*v1alpha1.CamelFleet{nsFleet, globalFleet} {
+		result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: fleet.Namespace, Name: fleet.Name}})
+		require.NoError(t, err)
+		// The worst applications success percentages are refreshed at the polling interval
+		assert.Equal(t, platform.GetPollingInterval(), result.RequeueAfter)
+	}
+
+	var fleet v1alpha1.CamelFleet
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "ns1", Name: "fleet"}, &fleet))
+	assert.Equal(t, 1, fleet.Status.Apps)
+	assert.Len(t, fleet.Status.WorstApps, 1)
+	assert.NotNil(t, fleet.Status.LastUpdateTime)
+
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "operator", Name: "fleet"}, &fleet))
+	assert.Equal(t, 2, fleet.Status.Apps)
+	assert.Equal(t, map[string]int{"Error": 1, "Warning": 1}, fleet.Status.SLIStatuses)
+	assert.Equal(t, []v1alpha1.FleetApp{
+		{Namespace: "ns1", Name: "app", Phase: v1alpha1.CamelAppPhaseRunning, SLIStatus: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "80.00"},
+	}, fleet.Status.WorstApps)
+
+	// The status is not patched when the summary does not change
+	lastUpdate := fleet.Status.LastUpdateTime
+	result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "operator", Name: "fleet"}})
+	require.NoError(t, err)
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "operator", Name: "fleet"}, &fleet))
+	assert.Equal(t, lastUpdate, fleet.Status.LastUpdateTime)
+	assert.Equal(t, platform.GetPollingInterval(), result.RequeueAfter)
+}
+
+func TestAppSummaryChangedPredicate(t *testing.T) {
+	p := appSummaryChangedPredicate{}
+	app := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app"},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:       v1alpha1.CamelAppPhaseRunning,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusSuccess, SuccessPercentage: "100.00"},
+			Pods: []v1alpha1.PodInfo{
+				{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Quarkus", CamelVersion: "4.10.0"}},
+			},
+		},
+	}
+
+	polled := app.DeepCopy()
+	polled.Status.History = []v1alpha1.HistorySample{{ExchangesTotal: 10}}
+	polled.Status.SuccessRate.SuccessPercentage = "99.90"
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: app, ObjectNew: polled}))
+
+	degraded := app.DeepCopy()
+	degraded.Status.SuccessRate.Status = v1alpha1.SLIExchangeStatusWarning
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: app, ObjectNew: degraded}))
+
+	upgraded := app.DeepCopy()
+	upgraded.Status.Pods[0].Runtime.CamelVersion = "4.11.0"
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: app, ObjectNew: upgraded}))
+}
@@ -0,0 +1,144 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+const (
+	// DefaultWorstApps is the number of worst performing applications listed when the fleet does not set it.
+	DefaultWorstApps = 5
+	// unknown accounts the applications which do not report a given information.
+	unknown = "Unknown"
+)
+
+// Summarize aggregates the given applications in a fleet status, listing at most worstApps degraded applications.
+func Summarize(apps []v1alpha1.CamelApp, worstApps int) v1alpha1.CamelFleetStatus {
+	status := v1alpha1.CamelFleetStatus{
+		Apps: len(apps),
+	}
+	if len(apps) == 0 {
+		return status
+	}
+	status.Phases = map[string]int{}
+	status.SLIStatuses = map[string]int{}
+	status.RuntimeProviders = map[string]int{}
+	status.CamelVersions = map[string]int{}
+
+	var degraded []v1alpha1.FleetApp
+	for i := range apps {
+		app := newFleetApp(&apps[i])
+		provider, camelVersion := getRuntime(&apps[i])
+		status.Phases[orUnknown(string(app.Phase))]++
+		status.SLIStatuses[orUnknown(string(app.SLIStatus))]++
+		status.RuntimeProviders[orUnknown(provider)]++
+		status.CamelVersions[orUnknown(camelVersion)]++
+		if severity(app) > 0 {
+			degraded = append(degraded, app)
+		}
+	}
+
+	sort.SliceStable(degraded, func(i, j int) bool {
+		if si, sj := severity(degraded[i]), severity(degraded[j]); si != sj {
+			return si > sj
+		}
+		if pi, pj := successPercentage(degraded[i]), successPercentage(degraded[j]); pi != pj {
+			return pi < pj
+		}
+		if degraded[i].Namespace != degraded[j].Namespace {
+			return degraded[i].Namespace < degraded[j].Namespace
+		}
+		return degraded[i].Name < degraded[j].Name
+	})
+	if len(degraded) > worstApps {
+		degraded = degraded[:worstApps]
+	}
+	if len(degraded) > 0 {
+		status.WorstApps = degraded
+	}
+
+	return status
+}
+
+// newFleetApp returns the fleet view of the given application.
+func newFleetApp(app *v1alpha1.CamelApp) v1alpha1.FleetApp {
+	fleetApp := v1alpha1.FleetApp{
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Phase:     app.Status.Phase,
+		SLIStatus: getSLIStatus(app),
+	}
+	if rate := app.Status.SuccessRate; rate != nil {
+		fleetApp.SuccessPercentage = rate.SuccessPercentage
+	}
+	return fleetApp
+}
+
+// getSLIStatus returns the exchange SLI status of the application, if any.
+func getSLIStatus(app *v1alpha1.CamelApp) v1alpha1.SLIExchangeStatus {
+	if rate := app.Status.SuccessRate; rate != nil {
+		return rate.Status
+	}
+	return ""
+}
+
+// getRuntime returns the runtime provider and Camel version reported by the first pod of the application providing them.
+func getRuntime(app *v1alpha1.CamelApp) (string, string) {
+	for _, pod := range app.Status.Pods {
+		if pod.Runtime != nil && (pod.Runtime.RuntimeProvider != "" || pod.Runtime.CamelVersion != "") {
+			return pod.Runtime.RuntimeProvider, pod.Runtime.CamelVersion
+		}
+	}
+	return "", ""
+}
+
+// severity ranks how degraded an application is, 0 meaning it is not degraded.
+func severity(app v1alpha1.FleetApp) int {
+	switch {
+	case app.Phase == v1alpha1.CamelAppPhaseError:
+		return 3
+	case app.SLIStatus == v1alpha1.SLIExchangeStatusError:
+		return 2
+	case app.SLIStatus == v1alpha1.SLIExchangeStatusWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// successPercentage returns the exchange success percentage of the application, the lowest ones being the worst.
+// An application not reporting it is ranked after the others.
+func successPercentage(app v1alpha1.FleetApp) float64 {
+	percentage, err := strconv.ParseFloat(app.SuccessPercentage, 64)
+	if err != nil {
+		return math.MaxFloat64
+	}
+	return percentage
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return unknown
+	}
+	return value
+}
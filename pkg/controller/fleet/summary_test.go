@@ -0,0 +1,95 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestSummarize(t *testing.T) {
+	quarkus := []v1alpha1.PodInfo{
+		{Name: "no-runtime"},
+		{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Quarkus", CamelVersion: "4.10.0"}},
+	}
+	springBoot := []v1alpha1.PodInfo{
+		{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Spring-Boot", CamelVersion: "4.8.0"}},
+	}
+	apps := []v1alpha1.CamelApp{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ok"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusSuccess, SuccessPercentage: "100.00"},
+				Pods:        quarkus,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "warning"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusWarning, SuccessPercentage: "96.00"},
+				Pods:        quarkus,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "error"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "80.00"},
+				Pods:        springBoot,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "worse"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "50.00"},
+				Pods:        springBoot,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "failing"},
+			Status:     v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhaseError},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "paused"},
+			Status:     v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhasePaused},
+		},
+	}
+
+	status := Summarize(apps, 3)
+	assert.Equal(t, 6, status.Apps)
+	assert.Equal(t, map[string]int{"Running": 4, "Error": 1, "Paused": 1}, status.Phases)
+	assert.Equal(t, map[string]int{"Success": 1, "Warning": 1, "Error": 2, "Unknown": 2}, status.SLIStatuses)
+	assert.Equal(t, map[string]int{"Quarkus": 2, "Spring-Boot": 2, "Unknown": 2}, status.RuntimeProviders)
+	assert.Equal(t, map[string]int{"4.10.0": 2, "4.8.0": 2, "Unknown": 2}, status.CamelVersions)
+	assert.Equal(t, []v1alpha1.FleetApp{
+		{Namespace: "ns2", Name: "failing", Phase: v1alpha1.CamelAppPhaseError},
+		{Namespace: "ns2", Name: "worse", Phase: v1alpha1.CamelAppPhaseRunning, SLIStatus: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "50.00"},
+		{Namespace: "ns2", Name: "error", Phase: v1alpha1.CamelAppPhaseRunning, SLIStatus: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "80.00"},
+	}, status.WorstApps)
+
+	assert.Len(t, Summarize(apps, 10).WorstApps, 4)
+	assert.Nil(t, Summarize(apps, 0).WorstApps)
+	assert.Equal(t, v1alpha1.CamelFleetStatus{}, Summarize(nil, 5))
+}
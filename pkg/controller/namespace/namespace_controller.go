@@ -0,0 +1,89 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"context"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/app"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/synthetic"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Add creates the controller tracking the namespaces matching the monitor namespace selector, if any. The manager cache
+// only holds the matching namespaces, so that a namespace which is unlabelled is seen as deleted.
+func Add(ctx context.Context, mgr manager.Manager, c client.Client) error {
+	if !platform.IsNamespaceSelectorEnabled() {
+		return nil
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("namespace-controller").
+		For(&corev1.Namespace{}).
+		Complete(&reconcileNamespace{
+			client:   c,
+			recorder: mgr.GetEventRecorderFor("camel-dashboard-namespace-controller"),
+		})
+}
+
+// reconcileNamespace adds and removes the namespaces from the monitored namespaces.
+type reconcileNamespace struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+func (r *reconcileNamespace) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	rlog := Log.WithValues("request-name", request.Name)
+	var ns corev1.Namespace
+	monitored := true
+	if err := r.client.Get(ctx, request.NamespacedName, &ns); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		monitored = false
+	} else if ns.DeletionTimestamp != nil {
+		monitored = false
+	}
+
+	if !platform.SetNamespaceMonitored(request.Name, monitored) {
+		return reconcile.Result{}, nil
+	}
+	if monitored {
+		rlog.Infof("Namespace %s selected, monitoring its Camel applications", request.Name)
+		app.NotifyNamespaceMonitored(ctx, request.Name)
+		if platform.IsCamelAppImportEnabled() {
+			if err := synthetic.ImportNamespace(ctx, r.client, r.recorder, request.Name); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	} else {
+		rlog.Infof("Namespace %s no longer selected, removing its synthetic Camel applications", request.Name)
+		if err := synthetic.ForgetNamespace(ctx, r.client, request.Name); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
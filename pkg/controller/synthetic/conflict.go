@@ -0,0 +1,237 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+var (
+	claimantsLock sync.RWMutex
+	// claimants holds the workloads claiming the same synthetic Camel application name, for the conflicting names only.
+	claimants = map[ctrl.ObjectKey][]string{}
+)
+
+// GetAppClaimants returns the workloads (as kind/name) claiming the name of a Camel application, if more than one.
+func GetAppClaimants(namespace, name string) []string {
+	claimantsLock.RLock()
+	defer claimantsLock.RUnlock()
+
+	return slices.Clone(claimants[ctrl.ObjectKey{Namespace: namespace, Name: name}])
+}
+
+// addClaimants records the workloads claiming the same Camel application name.
+func addClaimants(key ctrl.ObjectKey, sources ...string) {
+	claimantsLock.Lock()
+	defer claimantsLock.Unlock()
+	for _, source := range sources {
+		if !slices.Contains(claimants[key], source) {
+			claimants[key] = append(claimants[key], source)
+		}
+	}
+	sort.Strings(claimants[key])
+}
+
+// removeClaimant forgets a workload which no longer claims a Camel application name.
+func removeClaimant(key ctrl.ObjectKey, source string) {
+	claimantsLock.Lock()
+	defer claimantsLock.Unlock()
+	remaining := slices.DeleteFunc(claimants[key], func(s string) bool { return s == source })
+	if len(remaining) > 1 {
+		claimants[key] = remaining
+	} else {
+		delete(claimants, key)
+	}
+}
+
+// setClaimants replaces all the recorded workloads claiming the same Camel application names.
+func setClaimants(all map[ctrl.ObjectKey][]string) {
+	claimantsLock.Lock()
+	defer claimantsLock.Unlock()
+	claimants = all
+}
+
+// GetWorkloadKind returns the kind of a workload, as recorded by the synthetic Camel application imported from it.
+func GetWorkloadKind(obj ctrl.Object) string {
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return "Deployment"
+	case *batchv1.CronJob:
+		return "CronJob"
+	case *servingv1.Service:
+		return "KnativeService"
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
+
+// getWorkloadSource returns the kind and name of a workload, matching the one of the synthetic Camel application imported from it.
+func getWorkloadSource(obj ctrl.Object) string {
+	return GetWorkloadKind(obj) + "/" + obj.GetName()
+}
+
+// getAppSource returns the kind and name of the workload a synthetic Camel application was imported from.
+func getAppSource(app *v1alpha1.CamelApp) string {
+	return app.Annotations[v1alpha1.AppImportedKindLabel] + "/" + app.Annotations[v1alpha1.AppImportedNameLabel]
+}
+
+// suffixedAppName returns the name of a Camel application claimed by several workloads, for a workload which does not
+// win the name.
+func suffixedAppName(name, kind, workloadName string) string {
+	return fmt.Sprintf("%s-%s-%s", name, strings.ToLower(kind), workloadName)
+}
+
+// resolveConflicts returns the expected synthetic Camel applications once the name conflicts are resolved, and the
+// workloads claiming each conflicting name. The workload of the existing synthetic Camel application wins the name, or
+// else the first claimant. The others are dropped or, with the suffix policy, renamed. With the aggregate policy, they
+// are dropped too as they are aggregated into the winner Camel application, which records them, hence not reported as
+// conflicting.
+func resolveConflicts(expected []*v1alpha1.CamelApp, existing []v1alpha1.CamelApp, policy string) ([]*v1alpha1.CamelApp, map[ctrl.ObjectKey][]string) {
+	sources := map[ctrl.ObjectKey][]string{}
+	for _, app := range expected {
+		key := ctrl.ObjectKeyFromObject(app)
+		sources[key] = append(sources[key], getAppSource(app))
+	}
+	winners := map[ctrl.ObjectKey]string{}
+	for _, app := range existing {
+		key := ctrl.ObjectKeyFromObject(&app)
+		if slices.Contains(sources[key], getAppSource(&app)) {
+			winners[key] = getAppSource(&app)
+		}
+	}
+	conflicts := map[ctrl.ObjectKey][]string{}
+	var resolved []*v1alpha1.CamelApp
+	for _, app := range expected {
+		key := ctrl.ObjectKeyFromObject(app)
+		if len(sources[key]) > 1 && policy != platform.ConflictPolicyAggregate {
+			conflicts[key] = slices.Sorted(slices.Values(sources[key]))
+		}
+		winner, ok := winners[key]
+		if !ok {
+			winner = getAppSource(app)
+			winners[key] = winner
+		}
+		if winner == getAppSource(app) {
+			resolved = append(resolved, app)
+		} else if policy == platform.ConflictPolicySuffix {
+			app.Name = suffixedAppName(app.Name, app.Annotations[v1alpha1.AppImportedKindLabel], app.Annotations[v1alpha1.AppImportedNameLabel])
+			resolved = append(resolved, app)
+		}
+	}
+	if policy == platform.ConflictPolicyAggregate {
+		for _, app := range resolved {
+			setAggregatedSources(app, slices.DeleteFunc(slices.Clone(sources[ctrl.ObjectKeyFromObject(app)]), func(source string) bool {
+				return source == getAppSource(app)
+			}))
+		}
+	}
+
+	return resolved, conflicts
+}
+
+// GetAggregatedWorkloads returns the workloads aggregated into a synthetic Camel application, other than the one it
+// was imported from. These are the workloads claiming the same name, when the name conflict policy is aggregate, as
+// recorded by the application.
+func GetAggregatedWorkloads(ctx context.Context, c client.Client, app *v1alpha1.CamelApp) ([]ctrl.Object, error) {
+	if app.Annotations[v1alpha1.AppSyntheticLabel] != "true" || platform.GetAppNameConflictPolicy() != platform.ConflictPolicyAggregate {
+		return nil, nil
+	}
+	var workloads []ctrl.Object
+	for _, source := range getAggregatedSources(app) {
+		workload, err := getWorkload(ctx, c, app.Namespace, source)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		// The workload may no longer claim the name, until the application is synchronized
+		if workload != nil && isImported(workload) && getAppName(workload) == app.Name {
+			workloads = append(workloads, workload)
+		}
+	}
+
+	return workloads, nil
+}
+
+// getWorkload returns the workload identified by its kind and name. It returns nil if the kind is not supported.
+func getWorkload(ctx context.Context, c client.Client, namespace, source string) (ctrl.Object, error) {
+	kind, name, _ := strings.Cut(source, "/")
+	var workload ctrl.Object
+	switch kind {
+	case "Deployment":
+		workload = &appsv1.Deployment{}
+	case "CronJob":
+		workload = &batchv1.CronJob{}
+	case "KnativeService":
+		workload = &servingv1.Service{}
+	default:
+		return nil, nil
+	}
+	if err := c.Get(ctx, ctrl.ObjectKey{Namespace: namespace, Name: name}, workload); err != nil {
+		return nil, err
+	}
+
+	return workload, nil
+}
+
+// getAggregatedSources returns the workloads (as kind/name) aggregated into a synthetic Camel application.
+func getAggregatedSources(app *v1alpha1.CamelApp) []string {
+	if app.Annotations[v1alpha1.AppAggregatedSourcesAnnotation] == "" {
+		return nil
+	}
+	return strings.Split(app.Annotations[v1alpha1.AppAggregatedSourcesAnnotation], ",")
+}
+
+// setAggregatedSources records the workloads (as kind/name) aggregated into a synthetic Camel application.
+func setAggregatedSources(app *v1alpha1.CamelApp, sources []string) {
+	sources = slices.Compact(slices.Sorted(slices.Values(sources)))
+	if len(sources) == 0 {
+		delete(app.Annotations, v1alpha1.AppAggregatedSourcesAnnotation)
+		return
+	}
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[v1alpha1.AppAggregatedSourcesAnnotation] = strings.Join(sources, ",")
+}
+
+// patchAggregatedSources updates the workloads aggregated into a synthetic Camel application, if they changed.
+func patchAggregatedSources(ctx context.Context, c client.Client, app *v1alpha1.CamelApp, sources []string) error {
+	target := app.DeepCopy()
+	setAggregatedSources(target, sources)
+	if target.Annotations[v1alpha1.AppAggregatedSourcesAnnotation] == app.Annotations[v1alpha1.AppAggregatedSourcesAnnotation] {
+		return nil
+	}
+
+	return c.Patch(ctx, target, ctrl.MergeFrom(app), ctrl.FieldOwner("camel-dashboard-operator"))
+}
@@ -0,0 +1,104 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestResolveConflictsFirstWins(t *testing.T) {
+	expected := []*v1.CamelApp{
+		newSyntheticApp("my-app", "Deployment", "my-deploy"),
+		newSyntheticApp("my-app", "CronJob", "my-cron"),
+		newSyntheticApp("my-other-app", "Deployment", "my-other-deploy"),
+	}
+	existing := []v1.CamelApp{
+		*newSyntheticApp("my-app", "CronJob", "my-cron"),
+	}
+
+	resolved, conflicts := resolveConflicts(expected, existing, platform.ConflictPolicyFirstWins)
+	require.Len(t, resolved, 2)
+	assert.Equal(t, "CronJob/my-cron", getAppSource(resolved[0]))
+	assert.Equal(t, "my-other-app", resolved[1].Name)
+	assert.Equal(t, map[ctrl.ObjectKey][]string{
+		{Namespace: "ns", Name: "my-app"}: {"CronJob/my-cron", "Deployment/my-deploy"},
+	}, conflicts)
+
+	resolved, _ = resolveConflicts(expected, nil, platform.ConflictPolicyFirstWins)
+	require.Len(t, resolved, 2)
+	assert.Equal(t, "Deployment/my-deploy", getAppSource(resolved[0]))
+}
+
+func TestResolveConflictsSuffix(t *testing.T) {
+	expected := []*v1.CamelApp{
+		newSyntheticApp("my-app", "Deployment", "my-deploy"),
+		newSyntheticApp("my-app", "CronJob", "my-cron"),
+	}
+
+	resolved, conflicts := resolveConflicts(expected, nil, platform.ConflictPolicySuffix)
+	require.Len(t, resolved, 2)
+	assert.Equal(t, "my-app", resolved[0].Name)
+	assert.Equal(t, "my-app-cronjob-my-cron", resolved[1].Name)
+	assert.Len(t, conflicts, 1)
+}
+
+func TestAppClaimants(t *testing.T) {
+	defer setClaimants(map[ctrl.ObjectKey][]string{})
+	key := ctrl.ObjectKey{Namespace: "ns", Name: "my-app"}
+
+	addClaimants(key, "Deployment/my-deploy", "CronJob/my-cron")
+	addClaimants(key, "Deployment/my-deploy", "KnativeService/my-ksvc")
+	assert.Equal(t, []string{"CronJob/my-cron", "Deployment/my-deploy", "KnativeService/my-ksvc"}, GetAppClaimants("ns", "my-app"))
+
+	removeClaimant(key, "KnativeService/my-ksvc")
+	assert.Equal(t, []string{"CronJob/my-cron", "Deployment/my-deploy"}, GetAppClaimants("ns", "my-app"))
+	removeClaimant(key, "CronJob/my-cron")
+	assert.Empty(t, GetAppClaimants("ns", "my-app"))
+}
+
+func TestResolveConflictsAggregate(t *testing.T) {
+	expected := []*v1.CamelApp{
+		newSyntheticApp("my-app", "Deployment", "my-deploy"),
+		newSyntheticApp("my-app", "CronJob", "my-cron"),
+	}
+
+	resolved, conflicts := resolveConflicts(expected, nil, platform.ConflictPolicyAggregate)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, "Deployment/my-deploy", getAppSource(resolved[0]))
+	assert.Equal(t, []string{"CronJob/my-cron"}, getAggregatedSources(resolved[0]))
+	assert.Empty(t, conflicts)
+}
+
+func TestAggregatedSources(t *testing.T) {
+	app := newSyntheticApp("my-app", "Deployment", "my-deploy")
+	assert.Empty(t, getAggregatedSources(app))
+
+	setAggregatedSources(app, []string{"KnativeService/my-ksvc", "CronJob/my-cron", "KnativeService/my-ksvc"})
+	assert.Equal(t, "CronJob/my-cron,KnativeService/my-ksvc", app.Annotations[v1.AppAggregatedSourcesAnnotation])
+	assert.Equal(t, []string{"CronJob/my-cron", "KnativeService/my-ksvc"}, getAggregatedSources(app))
+
+	setAggregatedSources(app, nil)
+	assert.NotContains(t, app.Annotations, v1.AppAggregatedSourcesAnnotation)
+}
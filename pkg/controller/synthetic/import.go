@@ -0,0 +1,50 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// isImported returns true if the workload must be imported as a synthetic Camel application. The workload must match
+// the application label selector and, in annotation mode, must also be annotated for import. In any case, the workload
+// can opt out by the ignore annotation.
+func isImported(obj ctrl.Object) bool {
+	if obj.GetAnnotations()[v1alpha1.AppDashboardIgnoreAnnotation] == "true" {
+		return false
+	}
+	if platform.GetAppImportMode() == platform.ImportModeAnnotation &&
+		obj.GetAnnotations()[v1alpha1.AppDashboardImportAnnotation] != "true" {
+		return false
+	}
+	selector, err := platform.GetAppSelector()
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// getAppName returns the name of the synthetic Camel application imported from the workload, provided by the
+// application name label.
+func getAppName(obj ctrl.Object) string {
+	return obj.GetLabels()[platform.GetAppNameLabel()]
+}
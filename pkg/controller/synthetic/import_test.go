@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestIsImportedByLabel(t *testing.T) {
+	t.Setenv(platform.CamelAppImportMode, "")
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "my-deploy",
+			Labels: map[string]string{v1.AppLabel: "my-app"},
+		},
+	}
+	assert.True(t, isImported(deploy))
+	assert.Equal(t, "my-app", getAppName(deploy))
+
+	deploy.Annotations = map[string]string{v1.AppDashboardIgnoreAnnotation: "true"}
+	assert.False(t, isImported(deploy))
+
+	deploy.Annotations = map[string]string{v1.AppDashboardImportAnnotation: "true"}
+	deploy.Labels = nil
+	assert.False(t, isImported(deploy))
+	assert.Equal(t, "", getAppName(deploy))
+}
+
+func TestIsImportedByAnnotation(t *testing.T) {
+	t.Setenv(platform.CamelAppImportMode, platform.ImportModeAnnotation)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "my-deploy",
+			Labels: map[string]string{v1.AppLabel: "my-app"},
+		},
+	}
+	assert.False(t, isImported(deploy))
+
+	deploy.Annotations = map[string]string{v1.AppDashboardImportAnnotation: "true"}
+	assert.True(t, isImported(deploy))
+	assert.Equal(t, "my-app", getAppName(deploy))
+
+	// The annotation is an opt-in on top of the label
+	deploy.Labels = nil
+	deploy.Annotations[v1.AppLabel] = "my-annotated-app"
+	assert.False(t, isImported(deploy))
+	assert.Equal(t, "", getAppName(deploy))
+
+	deploy.Labels = map[string]string{v1.AppLabel: "my-app"}
+	deploy.Annotations[v1.AppDashboardIgnoreAnnotation] = "true"
+	assert.False(t, isImported(deploy))
+}
@@ -0,0 +1,227 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	jolokiaContextMBean = "org.apache.camel:type=context,*"
+	jolokiaRoutesMBean  = "org.apache.camel:type=routes,*"
+)
+
+var (
+	jolokiaContextAttributes = []string{
+		"CamelVersion", "State", "UptimeMillis",
+		"ExchangesTotal", "ExchangesCompleted", "ExchangesFailed", "ExchangesInflight",
+		"LastExchangeCompletedTimestamp", "LastExchangeFailureTimestamp",
+	}
+	jolokiaRoutesAttributes = []string{"RouteId", "State"}
+)
+
+// jolokiaRequest is a Jolokia read request as expected by the bulk POST protocol.
+type jolokiaRequest struct {
+	Type      string         `json:"type"`
+	MBean     string         `json:"mbean"`
+	Attribute []string       `json:"attribute,omitempty"`
+	Config    map[string]any `json:"config,omitempty"`
+}
+
+// jolokiaResponse is a Jolokia read response for an MBean pattern. The value is a map of MBean names
+// and their attributes.
+type jolokiaResponse struct {
+	Status int                       `json:"status"`
+	Error  string                    `json:"error,omitempty"`
+	Value  map[string]map[string]any `json:"value,omitempty"`
+}
+
+// setJolokia queries the Jolokia agent exposed by the Pod to fill the runtime information. It is meant to be used
+// for those applications which don't expose any metrics endpoint.
+func setJolokia(podInfo *v1alpha1.PodInfo, podIp string, port int, credentials *scrapeCredentials) error {
+	requests := []jolokiaRequest{
+		{
+			Type:      "read",
+			MBean:     jolokiaContextMBean,
+			Attribute: jolokiaContextAttributes,
+			Config:    map[string]any{"ignoreErrors": true},
+		},
+		{
+			Type:      "read",
+			MBean:     jolokiaRoutesMBean,
+			Attribute: jolokiaRoutesAttributes,
+			Config:    map[string]any{"ignoreErrors": true},
+		},
+	}
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return err
+	}
+	// NOTE: we're not using a proxy as a design choice in order
+	// to have a faster turnaround.
+	req, err := http.NewRequest("POST", fmt.Sprintf("http://%s:%d/%s", podIp, port, platform.DefaultJolokiaEndpoint), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	credentials.authorize(req)
+	resp, err := scrapeClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status not OK, it was %d", resp.StatusCode)
+	}
+
+	responses, err := parseJolokiaResponses(resp.Body)
+	if err != nil {
+		return err
+	}
+	if len(responses) != len(requests) {
+		return fmt.Errorf("expected %d Jolokia responses, got %d", len(requests), len(responses))
+	}
+	for _, response := range responses {
+		if response.Status != http.StatusOK {
+			return fmt.Errorf("jolokia request failed with status %d: %s", response.Status, response.Error)
+		}
+	}
+	if len(responses[0].Value) == 0 {
+		return fmt.Errorf("no Camel context MBean found")
+	}
+
+	podInfo.ObservabilityService.JolokiaEndpoint = platform.DefaultJolokiaEndpoint
+	podInfo.ObservabilityService.JolokiaPort = port
+	if podInfo.Runtime == nil {
+		podInfo.Runtime = &v1alpha1.RuntimeInfo{}
+	}
+	populateJolokiaContext(responses[0].Value, podInfo)
+	populateJolokiaRoutes(responses[1].Value, podInfo)
+
+	return nil
+}
+
+func parseJolokiaResponses(reader io.Reader) ([]jolokiaResponse, error) {
+	var responses []jolokiaResponse
+	if err := json.NewDecoder(reader).Decode(&responses); err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+func populateJolokiaContext(mbeans map[string]map[string]any, podInfo *v1alpha1.PodInfo) {
+	if len(mbeans) != 1 {
+		log.Infof("WARN: expected exactly one Camel context MBean, got %d", len(mbeans))
+	}
+	exchange := &v1alpha1.ExchangeInfo{}
+	for _, attributes := range mbeans {
+		if version, ok := attributes["CamelVersion"].(string); ok {
+			podInfo.Runtime.CamelVersion = version
+		}
+		if uptime := jolokiaInt(attributes["UptimeMillis"]); uptime > 0 {
+			podInfo.Runtime.UptimeTimestamp = &metav1.Time{Time: time.Now().Add(-time.Duration(uptime) * time.Millisecond)}
+		}
+		// The health endpoint has precedence, if available
+		if podInfo.Runtime.Status == "" {
+			podInfo.Runtime.Status = jolokiaContextStatus(attributes["State"])
+		}
+		exchange.Total += jolokiaInt(attributes["ExchangesTotal"])
+		exchange.Succeeded += jolokiaInt(attributes["ExchangesCompleted"])
+		exchange.Failed += jolokiaInt(attributes["ExchangesFailed"])
+		exchange.Pending += jolokiaInt(attributes["ExchangesInflight"])
+		for _, attribute := range []string{"LastExchangeCompletedTimestamp", "LastExchangeFailureTimestamp"} {
+			if timestamp := jolokiaTime(attributes[attribute]); timestamp != nil {
+				if exchange.LastTimestamp == nil || timestamp.After(exchange.LastTimestamp.Time) {
+					exchange.LastTimestamp = timestamp
+				}
+			}
+		}
+	}
+	podInfo.Runtime.Exchange = exchange
+}
+
+func populateJolokiaRoutes(mbeans map[string]map[string]any, podInfo *v1alpha1.PodInfo) {
+	routes := &v1alpha1.RoutesInfo{}
+	for _, attributes := range mbeans {
+		routes.Total++
+		state, _ := attributes["State"].(string)
+		switch state {
+		case "Started":
+			routes.Started++
+		case "Suspended", "Suspending":
+			routes.Suspended++
+		default:
+			routes.Stopped++
+		}
+	}
+	podInfo.Runtime.Routes = routes
+}
+
+// jolokiaContextStatus maps the Camel context state to the health status values.
+func jolokiaContextStatus(state any) string {
+	if s, ok := state.(string); ok && s == "Started" {
+		return "UP"
+	}
+
+	return "DOWN"
+}
+
+// jolokiaInt converts a JSON numeric attribute, the zero value is returned if the attribute is not a number.
+func jolokiaInt(value any) int {
+	if v, ok := value.(float64); ok {
+		return int(v)
+	}
+
+	return 0
+}
+
+// jolokiaTime converts a Jolokia date attribute, which is either serialized as an ISO-8601 string
+// or as epoch milliseconds according to the agent configuration.
+func jolokiaTime(value any) *metav1.Time {
+	switch v := value.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			log.Debugf("could not parse Jolokia date %s: %s", v, err.Error())
+			return nil
+		}
+		return &metav1.Time{Time: t}
+	case float64:
+		if v <= 0 {
+			return nil
+		}
+		return &metav1.Time{Time: time.UnixMilli(int64(v))}
+	}
+
+	return nil
+}
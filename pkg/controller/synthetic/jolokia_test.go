@@ -0,0 +1,133 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+const jolokiaBulkResponse = `[
+  {
+    "request": {"mbean": "org.apache.camel:type=context,*", "type": "read"},
+    "value": {
+      "org.apache.camel:context=my-ctx,name=\"my-ctx\",type=context": {
+        "CamelVersion": "4.10.0",
+        "State": "Started",
+        "UptimeMillis": 60000,
+        "ExchangesTotal": 10,
+        "ExchangesCompleted": 8,
+        "ExchangesFailed": 2,
+        "ExchangesInflight": 1,
+        "LastExchangeCompletedTimestamp": "2025-01-01T10:00:00Z",
+        "LastExchangeFailureTimestamp": "2025-01-01T11:00:00Z"
+      }
+    },
+    "status": 200
+  },
+  {
+    "request": {"mbean": "org.apache.camel:type=routes,*", "type": "read"},
+    "value": {
+      "org.apache.camel:context=my-ctx,name=\"route1\",type=routes": {"RouteId": "route1", "State": "Started"},
+      "org.apache.camel:context=my-ctx,name=\"route2\",type=routes": {"RouteId": "route2", "State": "Suspended"},
+      "org.apache.camel:context=my-ctx,name=\"route3\",type=routes": {"RouteId": "route3", "State": "Stopped"}
+    },
+    "status": 200
+  }
+]`
+
+func jolokiaTestServer(t *testing.T, response string) (string, int) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/jolokia", r.URL.Path)
+		var requests []jolokiaRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		assert.Len(t, requests, 2)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return host, p
+}
+
+func TestSetJolokia(t *testing.T) {
+	host, port := jolokiaTestServer(t, jolokiaBulkResponse)
+	podInfo := v1.PodInfo{ObservabilityService: &v1.ObservabilityServiceInfo{}}
+
+	require.NoError(t, setJolokia(&podInfo, host, port, nil))
+
+	assert.Equal(t, "jolokia", podInfo.ObservabilityService.JolokiaEndpoint)
+	assert.Equal(t, port, podInfo.ObservabilityService.JolokiaPort)
+	require.NotNil(t, podInfo.Runtime)
+	assert.Equal(t, "4.10.0", podInfo.Runtime.CamelVersion)
+	assert.Equal(t, "UP", podInfo.Runtime.Status)
+	require.NotNil(t, podInfo.Runtime.UptimeTimestamp)
+	assert.WithinDuration(t, time.Now().Add(-time.Minute), podInfo.Runtime.UptimeTimestamp.Time, 5*time.Second)
+	require.NotNil(t, podInfo.Runtime.Exchange)
+	assert.Equal(t, 10, podInfo.Runtime.Exchange.Total)
+	assert.Equal(t, 8, podInfo.Runtime.Exchange.Succeeded)
+	assert.Equal(t, 2, podInfo.Runtime.Exchange.Failed)
+	assert.Equal(t, 1, podInfo.Runtime.Exchange.Pending)
+	require.NotNil(t, podInfo.Runtime.Exchange.LastTimestamp)
+	assert.Equal(t, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC), podInfo.Runtime.Exchange.LastTimestamp.UTC())
+	assert.Equal(t, &v1.RoutesInfo{Total: 3, Started: 1, Suspended: 1, Stopped: 1}, podInfo.Runtime.Routes)
+}
+
+func TestSetJolokiaKeepsHealthStatus(t *testing.T) {
+	host, port := jolokiaTestServer(t, jolokiaBulkResponse)
+	podInfo := v1.PodInfo{
+		ObservabilityService: &v1.ObservabilityServiceInfo{},
+		Runtime:              &v1.RuntimeInfo{Status: "DOWN"},
+	}
+
+	require.NoError(t, setJolokia(&podInfo, host, port, nil))
+	assert.Equal(t, "DOWN", podInfo.Runtime.Status)
+}
+
+func TestSetJolokiaNoCamelContext(t *testing.T) {
+	host, port := jolokiaTestServer(t, `[{"value": {}, "status": 200}, {"value": {}, "status": 200}]`)
+	podInfo := v1.PodInfo{ObservabilityService: &v1.ObservabilityServiceInfo{}}
+
+	err := setJolokia(&podInfo, host, port, nil)
+	require.Error(t, err)
+	assert.Equal(t, "no Camel context MBean found", err.Error())
+	assert.Nil(t, podInfo.Runtime)
+}
+
+func TestJolokiaTime(t *testing.T) {
+	assert.Nil(t, jolokiaTime(nil))
+	assert.Nil(t, jolokiaTime(""))
+	assert.Nil(t, jolokiaTime("not-a-date"))
+	assert.Equal(t, time.UnixMilli(1735725600000).UTC(), jolokiaTime(float64(1735725600000)).UTC())
+	assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), jolokiaTime("2025-01-01T11:00:00+01:00").UTC())
+}
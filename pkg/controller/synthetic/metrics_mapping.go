@@ -0,0 +1,263 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The metrics consumed by the operator. These are the keys used to configure the metric-name mapping.
+const (
+	metricAppInfo                 = "appInfo"
+	metricExchangesTotal          = "exchangesTotal"
+	metricExchangesFailedTotal    = "exchangesFailedTotal"
+	metricExchangesSucceededTotal = "exchangesSucceededTotal"
+	metricExchangesInflight       = "exchangesInflight"
+	metricExchangesLastTimestamp  = "exchangesLastTimestamp"
+	metricRoutes                  = "routes"
+	metricRoutesRunning           = "routesRunning"
+)
+
+// The keys of the operator metrics mapping ConfigMap which are not a metric name.
+const (
+	metricsMappingPresetKey = "preset"
+	metricsMappingPrefixKey = "prefix"
+)
+
+const (
+	metricsMappingPresetCamelMain  = "camel-main"
+	metricsMappingPresetQuarkus    = "quarkus"
+	metricsMappingPresetSpringBoot = "spring-boot"
+)
+
+var (
+	operatorMetricsMappingLock sync.RWMutex
+	// operatorMetricsMapping holds the configuration of the operator metrics mapping ConfigMap, as last loaded.
+	operatorMetricsMapping metricsMappingConfig
+)
+
+// metricsMapping maps each metric consumed by the operator to the name exposed by the application.
+type metricsMapping map[string]string
+
+// defaultMetricsMapping is the naming exposed by the Camel Micrometer default naming strategy, as used by Camel Main.
+// It is used when no other configuration is provided.
+var defaultMetricsMapping = metricsMapping{
+	metricAppInfo:                 "app_info",
+	metricExchangesTotal:          "camel_exchanges_total",
+	metricExchangesFailedTotal:    "camel_exchanges_failed_total",
+	metricExchangesSucceededTotal: "camel_exchanges_succeeded_total",
+	metricExchangesInflight:       "camel_exchanges_inflight",
+	metricExchangesLastTimestamp:  "camel_exchanges_last_timestamp",
+	metricRoutes:                  "camel_routes",
+	metricRoutesRunning:           "camel_routes_running",
+}
+
+// quarkusMetricsMapping is the naming exposed by Camel Quarkus, whose Micrometer registry suffixes the routes gauges
+// with their base unit.
+var quarkusMetricsMapping = metricsMapping{
+	metricAppInfo:                 "app_info",
+	metricExchangesTotal:          "camel_exchanges_total",
+	metricExchangesFailedTotal:    "camel_exchanges_failed_total",
+	metricExchangesSucceededTotal: "camel_exchanges_succeeded_total",
+	metricExchangesInflight:       "camel_exchanges_inflight",
+	metricExchangesLastTimestamp:  "camel_exchanges_last_timestamp",
+	metricRoutes:                  "camel_routes_added_routes",
+	metricRoutesRunning:           "camel_routes_running_routes",
+}
+
+// springBootMetricsMapping is the naming exposed by the Camel Spring Boot metrics starter with its legacy naming
+// strategy, which keeps the Camel 3 camel case names, the counters being suffixed by the Prometheus registry.
+var springBootMetricsMapping = metricsMapping{
+	metricAppInfo:                 "app_info",
+	metricExchangesTotal:          "CamelExchangesTotal_total",
+	metricExchangesFailedTotal:    "CamelExchangesFailed_total",
+	metricExchangesSucceededTotal: "CamelExchangesSucceeded_total",
+	metricExchangesInflight:       "CamelExchangesInflight",
+	metricExchangesLastTimestamp:  "CamelExchangesLastTimestamp",
+	metricRoutes:                  "CamelRoutesAdded",
+	metricRoutesRunning:           "CamelRoutesRunning",
+}
+
+// metricsMappingPresets contains the naming exposed by default by each runtime.
+var metricsMappingPresets = map[string]metricsMapping{
+	metricsMappingPresetCamelMain:  defaultMetricsMapping,
+	metricsMappingPresetQuarkus:    quarkusMetricsMapping,
+	metricsMappingPresetSpringBoot: springBootMetricsMapping,
+}
+
+// name returns the name exposed by the application for the given metric.
+func (m metricsMapping) name(metric string) string {
+	if name, ok := m[metric]; ok {
+		return name
+	}
+	return defaultMetricsMapping[metric]
+}
+
+// metricsMappingConfig holds a metric-name mapping configuration, either provided by the operator or by an application.
+type metricsMappingConfig struct {
+	// preset is the name of the preset to start from
+	preset string
+	// prefix is a common prefix added to every preset metric name
+	prefix string
+	// names contains the metric names explicitly configured
+	names map[string]string
+}
+
+// resolveMetricsMapping returns the metric-name mapping resulting from the operator and the application configuration.
+// For each metric, an explicit application name wins over an explicit operator name, which wins over the preset name
+// (application preset, or operator preset, or Camel Main) with the configured prefix (application prefix or operator prefix).
+func resolveMetricsMapping(operatorConfig, appConfig metricsMappingConfig) metricsMapping {
+	preset := defaultMetricsMapping
+	for _, presetName := range []string{appConfig.preset, operatorConfig.preset} {
+		if presetName == "" {
+			continue
+		}
+		if p, ok := metricsMappingPresets[presetName]; ok {
+			preset = p
+			break
+		}
+		log.Infof("WARN: unknown metrics mapping preset %s, expected one of %s, %s, %s", presetName,
+			metricsMappingPresetCamelMain, metricsMappingPresetQuarkus, metricsMappingPresetSpringBoot)
+	}
+	prefix := operatorConfig.prefix
+	if appConfig.prefix != "" {
+		prefix = appConfig.prefix
+	}
+
+	mapping := metricsMapping{}
+	for metric, name := range preset {
+		mapping[metric] = prefix + name
+		if name, ok := operatorConfig.names[metric]; ok {
+			mapping[metric] = name
+		}
+		if name, ok := appConfig.names[metric]; ok {
+			mapping[metric] = name
+		}
+	}
+
+	return mapping
+}
+
+// parseMetricsMappingData returns the configuration contained in the operator metrics mapping ConfigMap data.
+func parseMetricsMappingData(data map[string]string) (metricsMappingConfig, error) {
+	config := metricsMappingConfig{
+		preset: strings.TrimSpace(data[metricsMappingPresetKey]),
+		prefix: strings.TrimSpace(data[metricsMappingPrefixKey]),
+		names:  map[string]string{},
+	}
+	for key, value := range data {
+		if key == metricsMappingPresetKey || key == metricsMappingPrefixKey {
+			continue
+		}
+		if _, ok := defaultMetricsMapping[key]; !ok {
+			return config, fmt.Errorf("unknown metric %s", key)
+		}
+		config.names[key] = strings.TrimSpace(value)
+	}
+
+	return config, nil
+}
+
+// parseMetricsMappingAnnotation returns the metric names contained in a comma separated list of metric=name pairs.
+func parseMetricsMappingAnnotation(value string) (map[string]string, error) {
+	names := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		metric, name, found := strings.Cut(pair, "=")
+		metric = strings.TrimSpace(metric)
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("expected metric=name pair, got %s", pair)
+		}
+		if _, ok := defaultMetricsMapping[metric]; !ok {
+			return nil, fmt.Errorf("unknown metric %s", metric)
+		}
+		names[metric] = name
+	}
+
+	return names, nil
+}
+
+// GetExchangesMetricNames returns the names of the total and failed exchanges metrics exposed by an application, as
+// resolved from the operator and the application metric-name mapping configuration.
+func GetExchangesMetricNames(annotations map[string]string) (string, string) {
+	mapping := resolveMetricsMapping(getOperatorMetricsMappingConfig(), getAppMetricsMappingConfig(annotations))
+	return mapping.name(metricExchangesTotal), mapping.name(metricExchangesFailedTotal)
+}
+
+// LoadOperatorMetricsMapping reads the operator metrics mapping ConfigMap, if any, and applies it to the applications
+// scraped from then on. The ConfigMap is not cached: it is meant to be read with the API reader of the manager when
+// the operator configuration is loaded, and on each resynchronization.
+func LoadOperatorMetricsMapping(ctx context.Context, reader ctrl.Reader) {
+	config := metricsMappingConfig{}
+	if name := platform.GetMetricsMappingConfigMap(); name != "" {
+		var cm corev1.ConfigMap
+		if err := reader.Get(ctx, ctrl.ObjectKey{Namespace: platform.GetOperatorNamespace(), Name: name}, &cm); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				// Keep the last mapping loaded rather than reverting to the default one on a transient error
+				log.Errorf(err, "could not load metrics mapping ConfigMap %s, keeping the current mapping", name)
+				return
+			}
+		} else if config, err = parseMetricsMappingData(cm.Data); err != nil {
+			log.Errorf(err, "could not properly parse metrics mapping ConfigMap %s, fallback to default mapping", name)
+			config = metricsMappingConfig{}
+		}
+	}
+
+	operatorMetricsMappingLock.Lock()
+	defer operatorMetricsMappingLock.Unlock()
+	operatorMetricsMapping = config
+}
+
+// getOperatorMetricsMappingConfig returns the metric-name mapping configuration loaded from the operator ConfigMap, if any.
+func getOperatorMetricsMappingConfig() metricsMappingConfig {
+	operatorMetricsMappingLock.RLock()
+	defer operatorMetricsMappingLock.RUnlock()
+
+	return operatorMetricsMapping
+}
+
+// getAppMetricsMappingConfig returns the metric-name mapping configuration provided by the application annotations.
+func getAppMetricsMappingConfig(annotations map[string]string) metricsMappingConfig {
+	config := metricsMappingConfig{
+		preset: annotations[v1alpha1.AppMetricsPresetAnnotation],
+		prefix: annotations[v1alpha1.AppMetricsPrefixAnnotation],
+	}
+	if annotations[v1alpha1.AppMetricsMappingAnnotation] != "" {
+		names, err := parseMetricsMappingAnnotation(annotations[v1alpha1.AppMetricsMappingAnnotation])
+		if err != nil {
+			log.Error(err, "could not properly parse application metrics mapping configuration, fallback to default operator value")
+		} else {
+			config.names = names
+		}
+	}
+
+	return config
+}
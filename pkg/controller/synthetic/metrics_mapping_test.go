@@ -0,0 +1,214 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestResolveMetricsMapping(t *testing.T) {
+	mapping := resolveMetricsMapping(metricsMappingConfig{}, metricsMappingConfig{})
+	assert.Equal(t, defaultMetricsMapping, mapping)
+
+	mapping = resolveMetricsMapping(
+		metricsMappingConfig{
+			preset: metricsMappingPresetQuarkus,
+			prefix: "ops_",
+			names:  map[string]string{metricAppInfo: "ops_info", metricRoutes: "ops_routes"},
+		},
+		metricsMappingConfig{
+			prefix: "myapp_",
+			names:  map[string]string{metricRoutes: "myapp_camel_routes_added"},
+		},
+	)
+	assert.Equal(t, "myapp_camel_exchanges_total", mapping.name(metricExchangesTotal))
+	assert.Equal(t, "ops_info", mapping.name(metricAppInfo))
+	assert.Equal(t, "myapp_camel_routes_added", mapping.name(metricRoutes))
+	assert.Equal(t, "myapp_camel_routes_running_routes", mapping.name(metricRoutesRunning))
+
+	// The application preset wins over the operator one
+	mapping = resolveMetricsMapping(metricsMappingConfig{preset: metricsMappingPresetQuarkus},
+		metricsMappingConfig{preset: metricsMappingPresetSpringBoot})
+	assert.Equal(t, springBootMetricsMapping, mapping)
+
+	mapping = resolveMetricsMapping(metricsMappingConfig{preset: "unknown"}, metricsMappingConfig{})
+	assert.Equal(t, defaultMetricsMapping, mapping)
+}
+
+func TestParseMetricsMappingData(t *testing.T) {
+	config, err := parseMetricsMappingData(map[string]string{
+		"preset":         "spring-boot",
+		"prefix":         "myapp_",
+		"exchangesTotal": " camel_exchanges ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, metricsMappingPresetSpringBoot, config.preset)
+	assert.Equal(t, "myapp_", config.prefix)
+	assert.Equal(t, map[string]string{metricExchangesTotal: "camel_exchanges"}, config.names)
+
+	_, err = parseMetricsMappingData(map[string]string{"exchanges": "camel_exchanges"})
+	require.Error(t, err)
+	assert.Equal(t, "unknown metric exchanges", err.Error())
+}
+
+func TestParseMetricsMappingAnnotation(t *testing.T) {
+	names, err := parseMetricsMappingAnnotation("exchangesTotal=camel_exchanges, routesRunning = camel_routes_started,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		metricExchangesTotal: "camel_exchanges",
+		metricRoutesRunning:  "camel_routes_started",
+	}, names)
+
+	_, err = parseMetricsMappingAnnotation("exchangesTotal")
+	require.Error(t, err)
+	_, err = parseMetricsMappingAnnotation("exchanges=camel_exchanges")
+	require.Error(t, err)
+}
+
+func TestGetAppMetricsMappingConfig(t *testing.T) {
+	config := getAppMetricsMappingConfig(map[string]string{
+		v1.AppMetricsPresetAnnotation:  "quarkus",
+		v1.AppMetricsPrefixAnnotation:  "myapp_",
+		v1.AppMetricsMappingAnnotation: "appInfo=myapp_info",
+	})
+	assert.Equal(t, metricsMappingConfig{
+		preset: metricsMappingPresetQuarkus,
+		prefix: "myapp_",
+		names:  map[string]string{metricAppInfo: "myapp_info"},
+	}, config)
+
+	config = getAppMetricsMappingConfig(map[string]string{
+		v1.AppMetricsMappingAnnotation: "wrong",
+	})
+	assert.Nil(t, config.names)
+}
+
+func TestPopulateMetricsWithPrefix(t *testing.T) {
+	payload := `# TYPE myapp_app_info gauge
+myapp_app_info{camel_runtime_provider="Main",camel_runtime_version="4.10.0",camel_version="4.10.0"} 1.0
+# TYPE myapp_camel_exchanges_total counter
+myapp_camel_exchanges_total{camelContext="ctx"} 10.0
+# TYPE myapp_camel_exchanges_failed_total counter
+myapp_camel_exchanges_failed_total{camelContext="ctx"} 3.0
+# TYPE myapp_camel_exchanges_succeeded_total counter
+myapp_camel_exchanges_succeeded_total{camelContext="ctx"} 7.0
+# TYPE camel_exchanges_total counter
+camel_exchanges_total{camelContext="ctx"} 99.0
+`
+	metrics, err := parseMetrics(strings.NewReader(payload), "text/plain; version=0.0.4")
+	require.NoError(t, err)
+	podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, resolveMetricsMapping(metricsMappingConfig{}, metricsMappingConfig{prefix: "myapp_"}), &podInfo)
+
+	assert.Equal(t, "Main", podInfo.Runtime.RuntimeProvider)
+	assert.Equal(t, "4.10.0", podInfo.Runtime.CamelVersion)
+	assert.Equal(t, &v1.ExchangeInfo{Total: 10, Failed: 3, Succeeded: 7}, podInfo.Runtime.Exchange)
+}
+
+func TestMetricsMappingPresetCamelMain(t *testing.T) {
+	payload := `# TYPE camel_exchanges_total counter
+camel_exchanges_total{camelContext="ctx"} 10.0
+# TYPE camel_exchanges_failed_total counter
+camel_exchanges_failed_total{camelContext="ctx"} 3.0
+# TYPE camel_routes gauge
+camel_routes{camelContext="ctx"} 2.0
+# TYPE camel_routes_running gauge
+camel_routes_running{camelContext="ctx"} 1.0
+`
+	metrics, err := parseMetrics(strings.NewReader(payload), "text/plain; version=0.0.4")
+	require.NoError(t, err)
+	podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, resolveMetricsMapping(metricsMappingConfig{preset: metricsMappingPresetCamelMain}, metricsMappingConfig{}), &podInfo)
+
+	assert.Equal(t, &v1.ExchangeInfo{Total: 10, Failed: 3}, podInfo.Runtime.Exchange)
+	assert.Equal(t, &v1.RoutesInfo{Total: 2, Started: 1}, podInfo.Runtime.Routes)
+}
+
+func TestMetricsMappingPresetQuarkus(t *testing.T) {
+	payload := `# TYPE camel_exchanges_total counter
+camel_exchanges_total{camelContext="ctx"} 10.0
+# TYPE camel_routes_added_routes gauge
+camel_routes_added_routes{camelContext="ctx"} 2.0
+# TYPE camel_routes_running_routes gauge
+camel_routes_running_routes{camelContext="ctx"} 1.0
+`
+	metrics, err := parseMetrics(strings.NewReader(payload), "text/plain; version=0.0.4")
+	require.NoError(t, err)
+	podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, resolveMetricsMapping(metricsMappingConfig{}, metricsMappingConfig{preset: metricsMappingPresetQuarkus}), &podInfo)
+	assert.Equal(t, &v1.RoutesInfo{Total: 2, Started: 1}, podInfo.Runtime.Routes)
+
+	// The routes gauges are not found with the Camel Main naming
+	podInfo = v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, defaultMetricsMapping, &podInfo)
+	assert.Nil(t, podInfo.Runtime.Routes)
+}
+
+func TestMetricsMappingPresetSpringBoot(t *testing.T) {
+	payload := `# TYPE CamelExchangesTotal_total counter
+CamelExchangesTotal_total{camelContext="ctx"} 10.0
+# TYPE CamelExchangesFailed_total counter
+CamelExchangesFailed_total{camelContext="ctx"} 3.0
+# TYPE CamelExchangesInflight gauge
+CamelExchangesInflight{camelContext="ctx"} 1.0
+# TYPE CamelRoutesAdded gauge
+CamelRoutesAdded{camelContext="ctx"} 2.0
+# TYPE CamelRoutesRunning gauge
+CamelRoutesRunning{camelContext="ctx"} 2.0
+`
+	metrics, err := parseMetrics(strings.NewReader(payload), "text/plain; version=0.0.4")
+	require.NoError(t, err)
+	podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, resolveMetricsMapping(metricsMappingConfig{}, metricsMappingConfig{preset: metricsMappingPresetSpringBoot}), &podInfo)
+	assert.Equal(t, &v1.ExchangeInfo{Total: 10, Failed: 3, Pending: 1}, podInfo.Runtime.Exchange)
+	assert.Equal(t, &v1.RoutesInfo{Total: 2, Started: 2}, podInfo.Runtime.Routes)
+
+	// The exchanges are not found with the Camel Main naming
+	podInfo = v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+	populateMetrics(metrics, defaultMetricsMapping, &podInfo)
+	assert.Equal(t, &v1.ExchangeInfo{}, podInfo.Runtime.Exchange)
+}
+
+func TestLoadOperatorMetricsMapping(t *testing.T) {
+	t.Setenv("NAMESPACE", "operator")
+	t.Setenv("METRICS_MAPPING_CONFIGMAP", "metrics-mapping")
+	t.Cleanup(func() { LoadOperatorMetricsMapping(context.Background(), fake.NewClientBuilder().Build()) })
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "metrics-mapping"},
+		Data:       map[string]string{"preset": "quarkus"},
+	}
+
+	LoadOperatorMetricsMapping(context.Background(), fake.NewClientBuilder().WithObjects(cm).Build())
+	assert.Equal(t, metricsMappingPresetQuarkus, getOperatorMetricsMappingConfig().preset)
+	total, _ := GetExchangesMetricNames(map[string]string{v1.AppMetricsPrefixAnnotation: "myapp_"})
+	assert.Equal(t, "myapp_camel_exchanges_total", total)
+
+	// The deleted ConfigMap reverts to the default mapping
+	LoadOperatorMetricsMapping(context.Background(), fake.NewClientBuilder().Build())
+	assert.Equal(t, metricsMappingConfig{}, getOperatorMetricsMappingConfig())
+}
@@ -0,0 +1,236 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	openMetricsType = "application/openmetrics-text"
+	// metricsAcceptHeader negotiates the exposition format, preferring the most efficient ones. Note that the text
+	// format must be accepted in any case as some runtimes, ie Quarkus, don't expose the protobuf format
+	// (see https://github.com/apache/camel-quarkus/issues/7405).
+	metricsAcceptHeader = expfmt.ProtoType + ";proto=" + expfmt.ProtoProtocol + ";encoding=delimited;q=0.7," +
+		openMetricsType + ";version=1.0.0;q=0.5," +
+		"text/plain;version=" + expfmt.TextVersion + ";q=0.3," +
+		"*/*;q=0.1"
+)
+
+// parseMetrics parses the metrics payload choosing the parser according to the response content type.
+// Any unknown content type is parsed as Prometheus text format.
+func parseMetrics(reader io.Reader, contentType string) (map[string]*dto.MetricFamily, error) {
+	switch metricsFormatType(contentType) {
+	case expfmt.TypeProtoDelim:
+		return parseProtobufMetrics(reader)
+	case expfmt.TypeOpenMetrics:
+		return parseOpenMetrics(reader)
+	default:
+		return parseTextMetrics(reader)
+	}
+}
+
+// metricsFormatType returns the exposition format type of a given content type.
+func metricsFormatType(contentType string) expfmt.FormatType {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return expfmt.TypeTextPlain
+	}
+	switch mediaType {
+	case expfmt.ProtoType:
+		if params["proto"] == expfmt.ProtoProtocol && params["encoding"] == "delimited" {
+			return expfmt.TypeProtoDelim
+		}
+	case openMetricsType:
+		return expfmt.TypeOpenMetrics
+	}
+
+	return expfmt.TypeTextPlain
+}
+
+func parseTextMetrics(reader io.Reader) (map[string]*dto.MetricFamily, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	mf, err := parser.TextToMetricFamilies(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return mf, nil
+}
+
+func parseProtobufMetrics(reader io.Reader) (map[string]*dto.MetricFamily, error) {
+	decoder := expfmt.NewDecoder(reader, expfmt.NewFormat(expfmt.TypeProtoDelim))
+	mf := map[string]*dto.MetricFamily{}
+	for {
+		family := &dto.MetricFamily{}
+		if err := decoder.Decode(family); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		mf[family.GetName()] = family
+	}
+
+	return mf, nil
+}
+
+// parseOpenMetrics parses the OpenMetrics text format. As the formats are very similar, the payload is converted
+// into the Prometheus text format, taking care of the differences which are relevant for the operator: the counters
+// and info families naming, the units, the created series, the exemplars and the EOF marker.
+func parseOpenMetrics(reader io.Reader) (map[string]*dto.MetricFamily, error) {
+	var buf bytes.Buffer
+	// family name -> type as declared in the OpenMetrics payload
+	families := map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "# EOF" {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			if converted := convertOpenMetricsDescriptor(line, families); converted != "" {
+				buf.WriteString(converted)
+				buf.WriteByte('\n')
+			}
+			continue
+		}
+		if converted := convertOpenMetricsSample(line, families); converted != "" {
+			buf.WriteString(converted)
+			buf.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return parseTextMetrics(&buf)
+}
+
+// convertOpenMetricsDescriptor converts the HELP and TYPE descriptors, and drops any other one (ie, UNIT).
+func convertOpenMetricsDescriptor(line string, families map[string]string) string {
+	tokens := strings.SplitN(line, " ", 4)
+	if len(tokens) < 3 {
+		return ""
+	}
+	switch tokens[1] {
+	case "TYPE":
+		if len(tokens) < 4 {
+			return ""
+		}
+		name, metricType := tokens[2], tokens[3]
+		families[name] = metricType
+		switch metricType {
+		case "counter":
+			return "# TYPE " + openMetricsSampleName(name, metricType) + " counter"
+		case "info", "stateset":
+			return "# TYPE " + openMetricsSampleName(name, metricType) + " gauge"
+		case "gauge", "histogram", "summary":
+			return line
+		default:
+			return "# TYPE " + name + " untyped"
+		}
+	case "HELP":
+		if metricType, ok := families[tokens[2]]; ok {
+			tokens[2] = openMetricsSampleName(tokens[2], metricType)
+		}
+		return strings.Join(tokens, " ")
+	}
+
+	return ""
+}
+
+// convertOpenMetricsSample removes the exemplar and the timestamp of a sample, and drops the created series which are
+// not expected by the Prometheus text format.
+func convertOpenMetricsSample(line string, families map[string]string) string {
+	if strings.TrimSpace(line) == "" {
+		return ""
+	}
+	nameEnd := strings.IndexAny(line, "{ ")
+	if nameEnd < 0 {
+		return ""
+	}
+	name := line[:nameEnd]
+	if strings.HasSuffix(name, "_created") {
+		if _, ok := families[strings.TrimSuffix(name, "_created")]; ok {
+			return ""
+		}
+	}
+	rest := line[nameEnd:]
+	labels := ""
+	if strings.HasPrefix(rest, "{") {
+		end := labelsEnd(rest)
+		if end < 0 {
+			return line
+		}
+		labels = rest[:end+1]
+		rest = rest[end+1:]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return line
+	}
+
+	return name + labels + " " + fields[0]
+}
+
+// labelsEnd returns the index of the closing brace of a label set, taking care of quoted label values.
+func labelsEnd(s string) int {
+	quoted := false
+	escaped := false
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == '}' && !quoted:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// openMetricsSampleName returns the name of the samples belonging to a family, as expected by the Prometheus text format.
+func openMetricsSampleName(name, metricType string) string {
+	switch metricType {
+	case "counter":
+		if !strings.HasSuffix(name, "_total") {
+			return name + "_total"
+		}
+	case "info":
+		if !strings.HasSuffix(name, "_info") {
+			return name + "_info"
+		}
+	}
+
+	return name
+}
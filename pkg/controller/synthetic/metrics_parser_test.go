@@ -0,0 +1,181 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+const (
+	textContentType        = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	protobufContentType    = "application/vnd.google.protobuf; proto=io.prometheus.client.MetricFamily; encoding=delimited"
+)
+
+func TestMetricsFormatType(t *testing.T) {
+	assert.Equal(t, expfmt.TypeTextPlain, metricsFormatType(textContentType))
+	assert.Equal(t, expfmt.TypeOpenMetrics, metricsFormatType(openMetricsContentType))
+	assert.Equal(t, expfmt.TypeProtoDelim, metricsFormatType(protobufContentType))
+	assert.Equal(t, expfmt.TypeTextPlain, metricsFormatType("application/vnd.google.protobuf; proto=io.prometheus.client.MetricFamily; encoding=text"))
+	assert.Equal(t, expfmt.TypeTextPlain, metricsFormatType(""))
+	assert.Equal(t, expfmt.TypeTextPlain, metricsFormatType("application/json"))
+}
+
+func TestParseMetricsFormats(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		contentType string
+	}{
+		{fixture: "metrics.txt", contentType: textContentType},
+		{fixture: "metrics.openmetrics.txt", contentType: openMetricsContentType},
+		{fixture: "metrics.pb", contentType: protobufContentType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			payload, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			require.NoError(t, err)
+			metrics, err := parseMetrics(bytes.NewReader(payload), tt.contentType)
+			require.NoError(t, err)
+
+			podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{Exchange: &v1.ExchangeInfo{}}}
+			require.Contains(t, metrics, "app_info")
+			populateRuntimeInfo(metrics["app_info"], "app_info", &podInfo)
+			require.Contains(t, metrics, "camel_exchanges_total")
+			populateExchangesTotal(metrics["camel_exchanges_total"], "camel_exchanges_total", &podInfo)
+			require.Contains(t, metrics, "camel_exchanges_failed_total")
+			populateExchangesFailedTotal(metrics["camel_exchanges_failed_total"], "camel_exchanges_failed_total", &podInfo)
+			require.Contains(t, metrics, "camel_exchanges_succeeded_total")
+			populateExchangesSucceededTotal(metrics["camel_exchanges_succeeded_total"], "camel_exchanges_succeeded_total", &podInfo)
+			require.Contains(t, metrics, "camel_exchanges_inflight")
+			populateExchangesInflight(metrics["camel_exchanges_inflight"], "camel_exchanges_inflight", &podInfo)
+			require.Contains(t, metrics, "camel_exchanges_last_timestamp")
+			populateExchangesLastTimestamp(metrics["camel_exchanges_last_timestamp"], "camel_exchanges_last_timestamp", &podInfo)
+			populateRoutes(metrics, defaultMetricsMapping, &podInfo)
+
+			assert.Equal(t, "Quarkus", podInfo.Runtime.RuntimeProvider)
+			assert.Equal(t, "3.20.0", podInfo.Runtime.RuntimeVersion)
+			assert.Equal(t, "4.10.0", podInfo.Runtime.CamelVersion)
+			assert.Equal(t, 12, podInfo.Runtime.Exchange.Total)
+			assert.Equal(t, 2, podInfo.Runtime.Exchange.Failed)
+			assert.Equal(t, 10, podInfo.Runtime.Exchange.Succeeded)
+			assert.Equal(t, 1, podInfo.Runtime.Exchange.Pending)
+			require.NotNil(t, podInfo.Runtime.Exchange.LastTimestamp)
+			assert.Equal(t, int64(1735725600000), podInfo.Runtime.Exchange.LastTimestamp.UnixMilli())
+			assert.Equal(t, &v1.RoutesInfo{Total: 2, Started: 1}, podInfo.Runtime.Routes)
+		})
+	}
+}
+
+func TestParseOpenMetricsDropsCreatedSeries(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "metrics.openmetrics.txt"))
+	require.NoError(t, err)
+	metrics, err := parseMetrics(bytes.NewReader(payload), openMetricsContentType)
+	require.NoError(t, err)
+
+	assert.NotContains(t, metrics, "camel_exchanges_created")
+	require.Contains(t, metrics, "camel_exchanges_total")
+	assert.Equal(t, dto.MetricType_COUNTER, metrics["camel_exchanges_total"].GetType())
+	assert.Len(t, metrics["camel_exchanges_total"].GetMetric(), 2)
+}
+
+// largeMetricFamilies returns the metric families exposed by an application with the given number of routes.
+func largeMetricFamilies(routes int) []*dto.MetricFamily {
+	counter := func(name string) *dto.MetricFamily {
+		family := &dto.MetricFamily{Name: ptr.To(name), Help: ptr.To(name), Type: dto.MetricType_COUNTER.Enum()}
+		for i := 0; i < routes; i++ {
+			family.Metric = append(family.Metric, &dto.Metric{
+				Label: []*dto.LabelPair{
+					{Name: ptr.To("camelContext"), Value: ptr.To("camel-1")},
+					{Name: ptr.To("kind"), Value: ptr.To("CamelRoute")},
+					{Name: ptr.To("routeId"), Value: ptr.To(fmt.Sprintf("route%d", i))},
+				},
+				Counter: &dto.Counter{Value: ptr.To(float64(i))},
+			})
+		}
+		return family
+	}
+
+	return []*dto.MetricFamily{
+		counter("camel_exchanges_total"),
+		counter("camel_exchanges_failed_total"),
+		counter("camel_exchanges_succeeded_total"),
+		counter("camel_exchanges_external_redeliveries_total"),
+		counter("camel_exchanges_failures_handled_total"),
+	}
+}
+
+func BenchmarkParseMetrics(b *testing.B) {
+	families := largeMetricFamilies(2000)
+	formats := []struct {
+		name        string
+		contentType string
+		encode      func(*bytes.Buffer, *dto.MetricFamily) error
+	}{
+		{
+			name:        "text",
+			contentType: textContentType,
+			encode: func(buf *bytes.Buffer, mf *dto.MetricFamily) error {
+				return expfmt.NewEncoder(buf, expfmt.NewFormat(expfmt.TypeTextPlain)).Encode(mf)
+			},
+		},
+		{
+			name:        "openmetrics",
+			contentType: openMetricsContentType,
+			encode: func(buf *bytes.Buffer, mf *dto.MetricFamily) error {
+				_, err := expfmt.MetricFamilyToOpenMetrics(buf, mf)
+				return err
+			},
+		},
+		{
+			name:        "protobuf",
+			contentType: protobufContentType,
+			encode: func(buf *bytes.Buffer, mf *dto.MetricFamily) error {
+				return expfmt.NewEncoder(buf, expfmt.NewFormat(expfmt.TypeProtoDelim)).Encode(mf)
+			},
+		},
+	}
+
+	for _, format := range formats {
+		var payload bytes.Buffer
+		for _, mf := range families {
+			require.NoError(b, format.encode(&payload, mf))
+		}
+		b.Run(format.name, func(b *testing.B) {
+			b.SetBytes(int64(payload.Len()))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := parseMetrics(bytes.NewReader(payload.Bytes()), format.contentType); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
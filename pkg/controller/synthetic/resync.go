@@ -0,0 +1,184 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+const (
+	resyncActionLabel = "action"
+
+	resyncActionCreated = "created"
+	resyncActionDeleted = "deleted"
+)
+
+var resyncCorrections = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "camel_dashboard_synthetic_resync_corrections_total",
+		Help: "Camel Dashboard synthetic Camel applications created or deleted by the resynchronization",
+	},
+	[]string{
+		resyncActionLabel,
+	},
+)
+
+func init() {
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(resyncCorrections)
+}
+
+// AddResync adds to the manager the resynchronization of the synthetic Camel applications, run on start and then
+// periodically. It recovers the events missed by the informers, ie, while the operator was down, and reloads the
+// operator metrics mapping ConfigMap.
+func AddResync(mgr manager.Manager, c client.Client) error {
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if !mgr.GetCache().WaitForCacheSync(ctx) {
+			return nil
+		}
+		for {
+			LoadOperatorMetricsMapping(ctx, mgr.GetAPIReader())
+			if err := ResyncSyntheticCamelApps(ctx, c); err != nil {
+				log.Error(err, "Some error happened while resynchronizing the synthetic Camel Applications")
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(platform.GetResyncInterval()):
+			}
+		}
+	}))
+}
+
+// ResyncSyntheticCamelApps creates the synthetic Camel applications missing for the imported workloads and deletes
+// the ones whose workload is no longer imported. The workloads claiming the same name are resolved with the name
+// conflict policy.
+func ResyncSyntheticCamelApps(ctx context.Context, c client.Client) error {
+	workloads, err := listImportedWorkloads(ctx, c, "")
+	if err != nil {
+		return err
+	}
+	// The oldest workload wins a conflicting name
+	slices.SortStableFunc(workloads, func(a, b ctrl.Object) int {
+		return a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time)
+	})
+	var expected []*v1alpha1.CamelApp
+	for _, workload := range workloads {
+		if !platform.IsNamespaceMonitored(workload.GetNamespace()) {
+			continue
+		}
+		adapter, err := NonManagedCamelApplicationFactory(workload)
+		if err != nil {
+			return err
+		}
+		expected = append(expected, adapter.CamelApp(ctx, c))
+	}
+	apps := v1alpha1.CamelAppList{}
+	if err := c.List(ctx, &apps); err != nil {
+		return err
+	}
+
+	expected, conflicts := resolveConflicts(expected, apps.Items, platform.GetAppNameConflictPolicy())
+	setClaimants(conflicts)
+
+	syncAggregatedSources(ctx, c, expected, apps.Items)
+	missing, orphans := resyncPlan(expected, apps.Items)
+	for _, app := range missing {
+		if err := createSyntheticCamelApp(ctx, c, app); err != nil && !k8serrors.IsAlreadyExists(err) {
+			log.Errorf(err, "Some error happened while creating a missing synthetic Camel Application %s/%s", app.Namespace, app.Name)
+			continue
+		}
+		resyncCorrections.WithLabelValues(resyncActionCreated).Inc()
+		log.Infof("Created missing synthetic Camel Application %s/%s", app.Namespace, app.Name)
+	}
+	for _, app := range orphans {
+		if err := c.Delete(ctx, &app); err != nil && !k8serrors.IsNotFound(err) {
+			log.Errorf(err, "Some error happened while deleting an orphan synthetic Camel Application %s/%s", app.Namespace, app.Name)
+			continue
+		}
+		resyncCorrections.WithLabelValues(resyncActionDeleted).Inc()
+		log.Infof("Deleted orphan synthetic Camel Application %s/%s", app.Namespace, app.Name)
+	}
+
+	return nil
+}
+
+// syncAggregatedSources records on the existing synthetic Camel applications the workloads aggregated into them, as
+// resolved by the name conflict policy.
+func syncAggregatedSources(ctx context.Context, c client.Client, expected []*v1alpha1.CamelApp, existing []v1alpha1.CamelApp) {
+	expectedApps := map[ctrl.ObjectKey]*v1alpha1.CamelApp{}
+	for _, app := range expected {
+		expectedApps[ctrl.ObjectKeyFromObject(app)] = app
+	}
+	for i := range existing {
+		app := &existing[i]
+		expectedApp, ok := expectedApps[ctrl.ObjectKeyFromObject(app)]
+		if !ok || app.Annotations[v1alpha1.AppSyntheticLabel] != "true" || getAppSource(app) != getAppSource(expectedApp) {
+			continue
+		}
+		if err := patchAggregatedSources(ctx, c, app, getAggregatedSources(expectedApp)); err != nil {
+			log.Errorf(err, "Some error happened while updating the resources aggregated into a synthetic Camel Application %s/%s", app.Namespace, app.Name)
+		}
+	}
+}
+
+// resyncPlan returns the expected synthetic Camel applications which are missing, and the existing synthetic Camel
+// applications which are not expected, ie, whose workload no longer exists or is no longer imported.
+func resyncPlan(expected []*v1alpha1.CamelApp, existing []v1alpha1.CamelApp) ([]*v1alpha1.CamelApp, []v1alpha1.CamelApp) {
+	existingApps := map[ctrl.ObjectKey]bool{}
+	for _, app := range existing {
+		existingApps[ctrl.ObjectKeyFromObject(&app)] = true
+	}
+	sources := map[ctrl.ObjectKey]map[string]bool{}
+	var missing []*v1alpha1.CamelApp
+	for _, app := range expected {
+		key := ctrl.ObjectKeyFromObject(app)
+		if sources[key] == nil {
+			sources[key] = map[string]bool{}
+		}
+		sources[key][getAppSource(app)] = true
+		if !existingApps[key] {
+			existingApps[key] = true
+			missing = append(missing, app)
+		}
+	}
+	var orphans []v1alpha1.CamelApp
+	for _, app := range existing {
+		if app.Annotations[v1alpha1.AppSyntheticLabel] != "true" {
+			continue
+		}
+		if !sources[ctrl.ObjectKeyFromObject(&app)][getAppSource(&app)] {
+			orphans = append(orphans, app)
+		}
+	}
+
+	return missing, orphans
+}
@@ -0,0 +1,62 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func newSyntheticApp(name, kind, source string) *v1.CamelApp {
+	app := v1.NewApp("ns", name)
+	app.Annotations = map[string]string{
+		v1.AppImportedNameLabel: source,
+		v1.AppImportedKindLabel: kind,
+		v1.AppSyntheticLabel:    "true",
+	}
+	return &app
+}
+
+func TestResyncPlan(t *testing.T) {
+	expected := []*v1.CamelApp{
+		newSyntheticApp("my-app", "Deployment", "my-deploy"),
+		newSyntheticApp("my-missing-app", "CronJob", "my-cron"),
+	}
+	managed := v1.NewApp("ns", "my-managed-app")
+	existing := []v1.CamelApp{
+		*newSyntheticApp("my-app", "Deployment", "my-deploy"),
+		*newSyntheticApp("my-unlabelled-app", "Deployment", "my-unlabelled-deploy"),
+		*newSyntheticApp("my-renamed-app", "CronJob", "my-cron"),
+		managed,
+	}
+
+	missing, orphans := resyncPlan(expected, existing)
+	require.Len(t, missing, 1)
+	assert.Equal(t, "my-missing-app", missing[0].Name)
+	require.Len(t, orphans, 2)
+	assert.Equal(t, "my-unlabelled-app", orphans[0].Name)
+	assert.Equal(t, "my-renamed-app", orphans[1].Name)
+
+	missing, orphans = resyncPlan(expected, append(existing, *missing[0]))
+	assert.Empty(t, missing)
+	assert.Len(t, orphans, 2)
+}
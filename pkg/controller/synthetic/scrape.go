@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScrapeTokenKey is the Secret key holding the token used for the bearer authentication.
+const ScrapeTokenKey = "token"
+
+// scrapeClient is the HTTP client shared by the scrape requests. The timeout prevents an unresponsive Pod
+// from blocking the monitoring of the application.
+var scrapeClient = &http.Client{Timeout: 10 * time.Second}
+
+// scrapeConfig holds the configuration used to scrape the observability services of the Pods.
+type scrapeConfig struct {
+	port            int
+	metricsEndpoint string
+	healthEndpoint  string
+	mapping         metricsMapping
+	credentials     *scrapeCredentials
+}
+
+// newScrapeConfig returns the scrape configuration of an application.
+func newScrapeConfig(ctx context.Context, c client.Client, namespace string, settings platform.AppSettings, mapping metricsMapping) (scrapeConfig, error) {
+	config := scrapeConfig{
+		port:            settings.ObservabilityPort,
+		metricsEndpoint: strings.TrimPrefix(settings.MetricsEndpoint, "/"),
+		healthEndpoint:  strings.TrimPrefix(settings.HealthEndpoint, "/"),
+		mapping:         mapping,
+	}
+	credentials, err := getScrapeCredentials(ctx, c, namespace, settings.Auth)
+	config.credentials = credentials
+
+	return config, err
+}
+
+// scrapeCredentials holds the credentials used to authenticate the scrape requests.
+type scrapeCredentials struct {
+	authType v1alpha1.ScrapeAuthType
+	username string
+	password string
+	token    string
+}
+
+// authorize sets the authentication of a scrape request, if any.
+func (sc *scrapeCredentials) authorize(req *http.Request) {
+	if sc == nil {
+		return
+	}
+	switch sc.authType {
+	case v1alpha1.ScrapeAuthTypeBasic:
+		req.SetBasicAuth(sc.username, sc.password)
+	case v1alpha1.ScrapeAuthTypeBearer:
+		req.Header.Set("Authorization", "Bearer "+sc.token)
+	}
+}
+
+// getScrapeCredentials loads the credentials from the Secret referenced by the scrape authentication, if any.
+func getScrapeCredentials(ctx context.Context, c client.Client, namespace string, auth *v1alpha1.ScrapeAuth) (*scrapeCredentials, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	// The Secrets are not cached, so that the operator neither watches nor keeps in memory the Secrets of the cluster
+	secret, err := c.CoreV1().Secrets(namespace).Get(ctx, auth.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return scrapeCredentialsFromSecret(auth.Type, secret)
+}
+
+func scrapeCredentialsFromSecret(authType v1alpha1.ScrapeAuthType, secret *corev1.Secret) (*scrapeCredentials, error) {
+	credentials := &scrapeCredentials{authType: authType}
+	switch authType {
+	case v1alpha1.ScrapeAuthTypeBasic:
+		credentials.username = string(secret.Data[corev1.BasicAuthUsernameKey])
+		credentials.password = string(secret.Data[corev1.BasicAuthPasswordKey])
+		if credentials.username == "" {
+			return nil, fmt.Errorf("secret %s has no %s key", secret.Name, corev1.BasicAuthUsernameKey)
+		}
+	case v1alpha1.ScrapeAuthTypeBearer:
+		credentials.token = string(secret.Data[ScrapeTokenKey])
+		if credentials.token == "" {
+			return nil, fmt.Errorf("secret %s has no %s key", secret.Name, ScrapeTokenKey)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported scrape authentication type %s", authType)
+	}
+
+	return credentials, nil
+}
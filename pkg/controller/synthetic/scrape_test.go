@@ -0,0 +1,68 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestScrapeCredentials(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("user"),
+			corev1.BasicAuthPasswordKey: []byte("pwd"),
+			ScrapeTokenKey:              []byte("my-token"),
+		},
+	}
+
+	basic, err := scrapeCredentialsFromSecret(v1.ScrapeAuthTypeBasic, secret)
+	require.NoError(t, err)
+	req, err := http.NewRequest("GET", "http://localhost/observe/metrics", nil)
+	require.NoError(t, err)
+	basic.authorize(req)
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pwd", password)
+
+	bearer, err := scrapeCredentialsFromSecret(v1.ScrapeAuthTypeBearer, secret)
+	require.NoError(t, err)
+	req, err = http.NewRequest("GET", "http://localhost/observe/metrics", nil)
+	require.NoError(t, err)
+	bearer.authorize(req)
+	assert.Equal(t, "Bearer my-token", req.Header.Get("Authorization"))
+
+	var none *scrapeCredentials
+	req, err = http.NewRequest("GET", "http://localhost/observe/metrics", nil)
+	require.NoError(t, err)
+	none.authorize(req)
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	_, err = scrapeCredentialsFromSecret(v1.ScrapeAuthTypeBearer, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty"}})
+	require.Error(t, err)
+	assert.Equal(t, "secret empty has no token key", err.Error())
+}
@@ -21,16 +21,23 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sync"
 
 	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/event"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/kubernetes"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	clientgocache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -39,12 +46,19 @@ import (
 
 var (
 	controller = true
+
+	workloadKindsOnce sync.Once
+	// cronJobsImported and knativeServicesImported tell whether the optional workload kinds are imported
+	cronJobsImported, knativeServicesImported bool
 )
 
 // ManageSyntheticCamelApps is the controller for synthetic Camel Applications. Consider that the lifecycle of the objects are driven
 // by the way we are monitoring them. Since we're filtering by some label in the cached client, you must consider an add, update or delete
 // accordingly, ie, when the user label the resource, then it is considered as an add, when it removes the label, it is considered as a delete.
-func ManageSyntheticCamelApps(ctx context.Context, c client.Client, cache cache.Cache) error {
+// The import annotations are instead managed on update, ie, when the user annotates the resource to be ignored, it is considered as a delete.
+// Any other update synchronizes the synthetic Camel Application name, annotations and owner references with the resource.
+// The resources claiming an already imported Camel Application name are reported with an event.
+func ManageSyntheticCamelApps(ctx context.Context, c client.Client, cache cache.Cache, recorder record.EventRecorder) error {
 	informers, err := getInformers(ctx, c, cache)
 	if err != nil {
 		return err
@@ -58,7 +72,21 @@ func ManageSyntheticCamelApps(ctx context.Context, c client.Client, cache cache.
 					return
 				}
 
-				onAdd(ctx, c, ctrlObj)
+				onAdd(ctx, c, recorder, ctrlObj)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldCtrlObj, ok := oldObj.(ctrl.Object)
+				if !ok {
+					log.Errorf(fmt.Errorf("type assertion failed: %v", oldObj), "Failed to retrieve Object on update event")
+					return
+				}
+				newCtrlObj, ok := newObj.(ctrl.Object)
+				if !ok {
+					log.Errorf(fmt.Errorf("type assertion failed: %v", newObj), "Failed to retrieve Object on update event")
+					return
+				}
+
+				onUpdate(ctx, c, recorder, oldCtrlObj, newCtrlObj)
 			},
 			DeleteFunc: func(obj interface{}) {
 				ctrlObj, ok := obj.(ctrl.Object)
@@ -67,7 +95,7 @@ func ManageSyntheticCamelApps(ctx context.Context, c client.Client, cache cache.
 					return
 				}
 
-				onDelete(ctx, c, ctrlObj)
+				onDelete(ctx, c, recorder, ctrlObj)
 			},
 		})
 		if err != nil {
@@ -78,21 +106,56 @@ func ManageSyntheticCamelApps(ctx context.Context, c client.Client, cache cache.
 	return nil
 }
 
-func onAdd(ctx context.Context, c client.Client, ctrlObj ctrl.Object) {
+func onAdd(ctx context.Context, c client.Client, recorder record.EventRecorder, ctrlObj ctrl.Object) {
+	if !platform.IsNamespaceMonitored(ctrlObj.GetNamespace()) {
+		log.Debugf("Skipping %s resource named %s as namespace %s is not monitored",
+			ctrlObj.GetObjectKind().GroupVersionKind().Kind, ctrlObj.GetName(), ctrlObj.GetNamespace())
+		return
+	}
+	if !isImported(ctrlObj) {
+		log.Debugf("Skipping %s resource named %s in namespace %s as it is not selected for import",
+			ctrlObj.GetObjectKind().GroupVersionKind().Kind, ctrlObj.GetName(), ctrlObj.GetNamespace())
+		return
+	}
 	log.Infof("Detected a new %s resource named %s in namespace %s",
 		ctrlObj.GetObjectKind().GroupVersionKind().Kind, ctrlObj.GetName(), ctrlObj.GetNamespace())
-	appName := ctrlObj.GetLabels()[v1alpha1.AppLabel]
+	appName := getAppName(ctrlObj)
 	app, err := getSyntheticCamelApp(ctx, c, ctrlObj.GetNamespace(), appName)
+	if err == nil && getAppSource(app) != getWorkloadSource(ctrlObj) {
+		// Name conflict: the Camel Application is already imported from another resource
+		if platform.GetAppNameConflictPolicy() == platform.ConflictPolicyAggregate {
+			log.Infof("Synthetic Camel Application %s already imported from %s, aggregating %s resource named %s",
+				appName, getAppSource(app), GetWorkloadKind(ctrlObj), ctrlObj.GetName())
+			if err := patchAggregatedSources(ctx, c, app, append(getAggregatedSources(app), getWorkloadSource(ctrlObj))); err != nil {
+				log.Errorf(err, "Some error happened while aggregating a resource into a synthetic Camel Application %s", appName)
+			}
+			return
+		}
+		addClaimants(ctrl.ObjectKeyFromObject(app), getAppSource(app), getWorkloadSource(ctrlObj))
+		if platform.GetAppNameConflictPolicy() != platform.ConflictPolicySuffix {
+			event.NotifyAppNameConflict(recorder, ctrlObj, appName, getAppSource(app), "")
+			log.Infof("Synthetic Camel Application %s already imported from %s. Skipping.", appName, getAppSource(app))
+			return
+		}
+		event.NotifyAppNameConflict(recorder, ctrlObj, appName, getAppSource(app),
+			suffixedAppName(appName, GetWorkloadKind(ctrlObj), ctrlObj.GetName()))
+		appName = suffixedAppName(appName, GetWorkloadKind(ctrlObj), ctrlObj.GetName())
+		app, err = getSyntheticCamelApp(ctx, c, ctrlObj.GetNamespace(), appName)
+	}
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			adapter, err := NonManagedCamelApplicationFactory(ctrlObj)
 			if err != nil {
 				log.Errorf(err, "Some error happened while creating a Camel application adapter for %s", appName)
+				return
 			}
-			if err = createSyntheticCamelApp(ctx, c, adapter.CamelApp(ctx, c)); err != nil {
+			newApp := adapter.CamelApp(ctx, c)
+			newApp.Name = appName
+			if err = createSyntheticCamelApp(ctx, c, newApp); err != nil {
 				log.Errorf(err, "Some error happened while creating a synthetic Camel Application %s", appName)
+				return
 			}
-			log.Infof("Created a synthetic Camel Application %s after %s resource object", app.GetName(), ctrlObj.GetName())
+			log.Infof("Created a synthetic Camel Application %s after %s resource object", appName, ctrlObj.GetName())
 		} else {
 			log.Errorf(err, "Some error happened while loading a synthetic Camel Application %s", appName)
 		}
@@ -101,13 +164,163 @@ func onAdd(ctx context.Context, c client.Client, ctrlObj ctrl.Object) {
 	}
 }
 
-func onDelete(ctx context.Context, c client.Client, ctrlObj ctrl.Object) {
-	appName := ctrlObj.GetLabels()[v1alpha1.AppLabel]
+// onUpdate imports or deletes the synthetic Camel Application when the workload import selection changes. When the
+// workload is still imported, the synthetic Camel Application is recreated if the application name changed, or else
+// synchronized with the workload annotations and owner references.
+func onUpdate(ctx context.Context, c client.Client, recorder record.EventRecorder, oldObj, newObj ctrl.Object) {
+	wasImported, imported := isImported(oldObj), isImported(newObj)
+	switch {
+	case !wasImported && imported:
+		onAdd(ctx, c, recorder, newObj)
+	case wasImported && !imported:
+		onDelete(ctx, c, recorder, oldObj)
+	case imported && getAppName(oldObj) != getAppName(newObj):
+		log.Infof("Detected a new application name for %s resource named %s in namespace %s: %s renamed to %s",
+			newObj.GetObjectKind().GroupVersionKind().Kind, newObj.GetName(), newObj.GetNamespace(), getAppName(oldObj), getAppName(newObj))
+		onDelete(ctx, c, recorder, oldObj)
+		onAdd(ctx, c, recorder, newObj)
+	case imported:
+		if err := syncSyntheticCamelApp(ctx, c, recorder, newObj); err != nil {
+			log.Errorf(err, "Some error happened while synchronizing a synthetic Camel Application %s", getAppName(newObj))
+		}
+	}
+}
+
+// syncSyntheticCamelApp synchronizes the imported annotations and owner references of a synthetic Camel Application
+// with its workload.
+func syncSyntheticCamelApp(ctx context.Context, c client.Client, recorder record.EventRecorder, ctrlObj ctrl.Object) error {
+	app, err := findSyntheticCamelApp(ctx, c, ctrlObj)
+	if err != nil {
+		return err
+	}
+	if app == nil {
+		if _, err := getSyntheticCamelApp(ctx, c, ctrlObj.GetNamespace(), getAppName(ctrlObj)); k8serrors.IsNotFound(err) {
+			// Missing, ie, the workload was not imported because its namespace was not monitored
+			onAdd(ctx, c, recorder, ctrlObj)
+		}
+		// Otherwise the name is claimed by another workload
+		return nil
+	}
+	adapter, err := NonManagedCamelApplicationFactory(ctrlObj)
+	if err != nil {
+		return err
+	}
+	target := app.DeepCopy()
+	if !syncCamelAppMetadata(target, adapter.CamelApp(ctx, c)) {
+		return nil
+	}
+	if err := c.Patch(ctx, target, ctrl.MergeFrom(app), ctrl.FieldOwner("camel-dashboard-operator")); err != nil {
+		return err
+	}
+	log.Infof("Synchronized synthetic Camel Application %s after %s resource object", app.GetName(), ctrlObj.GetName())
+
+	return nil
+}
+
+// syncCamelAppMetadata copies the camel annotations and owner references of the expected Camel Application into the
+// actual one. It returns true if anything changed.
+func syncCamelAppMetadata(app *v1alpha1.CamelApp, expected *v1alpha1.CamelApp) bool {
+	base := app.DeepCopy()
+	// The workload the application is imported from does not know the aggregated ones
+	aggregated := getAggregatedSources(app)
+	app.SyncCamelAnnotations(expected.Annotations)
+	setAggregatedSources(app, aggregated)
+	app.SetOwnerReferences(expected.GetOwnerReferences())
+
+	return !reflect.DeepEqual(base.Annotations, app.Annotations) ||
+		!reflect.DeepEqual(base.GetOwnerReferences(), app.GetOwnerReferences())
+}
+
+func onDelete(ctx context.Context, c client.Client, recorder record.EventRecorder, ctrlObj ctrl.Object) {
+	if !isImported(ctrlObj) {
+		// The resource was not imported, or its synthetic Camel Application was already deleted on update
+		return
+	}
+	appName := getAppName(ctrlObj)
+	removeClaimant(ctrl.ObjectKey{Namespace: ctrlObj.GetNamespace(), Name: appName}, getWorkloadSource(ctrlObj))
 	// Importing label removed
-	if err := deleteSyntheticCamelApp(ctx, c, ctrlObj.GetNamespace(), appName); err != nil {
-		log.Errorf(err, "Some error happened while deleting a synthetic Camel Application %s", appName)
+	app, err := findSyntheticCamelApp(ctx, c, ctrlObj)
+	if err != nil {
+		log.Errorf(err, "Some error happened while loading a synthetic Camel Application %s", appName)
+		return
+	}
+	if app == nil {
+		// The name is claimed by another workload, which may aggregate this one
+		if platform.GetAppNameConflictPolicy() == platform.ConflictPolicyAggregate {
+			removeAggregatedSource(ctx, c, ctrlObj.GetNamespace(), appName, getWorkloadSource(ctrlObj))
+		}
+		return
+	}
+	aggregated, err := GetAggregatedWorkloads(ctx, c, app)
+	if err != nil {
+		log.Errorf(err, "Some error happened while loading the resources aggregated into a synthetic Camel Application %s", appName)
+	}
+	if err := c.Delete(ctx, app); err != nil && !k8serrors.IsNotFound(err) {
+		log.Errorf(err, "Some error happened while deleting a synthetic Camel Application %s", app.Name)
+		return
+	}
+	log.Infof("Deleted synthetic Camel Application %s", app.Name)
+	if len(aggregated) > 0 {
+		// Import the Camel Application again from the remaining aggregated resources
+		reimportAggregatedApp(ctx, c, appName, aggregated)
 	}
-	log.Infof("Deleted synthetic Camel Application %s", appName)
+}
+
+// removeAggregatedSource forgets a workload aggregated into a synthetic Camel Application.
+func removeAggregatedSource(ctx context.Context, c client.Client, namespace, appName, source string) {
+	app, err := getSyntheticCamelApp(ctx, c, namespace, appName)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorf(err, "Some error happened while loading a synthetic Camel Application %s", appName)
+		}
+		return
+	}
+	sources := slices.DeleteFunc(getAggregatedSources(app), func(s string) bool { return s == source })
+	if err := patchAggregatedSources(ctx, c, app, sources); err != nil {
+		log.Errorf(err, "Some error happened while updating the resources aggregated into a synthetic Camel Application %s", appName)
+	}
+}
+
+// reimportAggregatedApp creates a synthetic Camel Application from the first of the workloads it aggregated, once
+// the one it was imported from is gone, aggregating the others.
+func reimportAggregatedApp(ctx context.Context, c client.Client, appName string, workloads []ctrl.Object) {
+	adapter, err := NonManagedCamelApplicationFactory(workloads[0])
+	if err != nil {
+		log.Errorf(err, "Some error happened while creating a Camel application adapter for %s", appName)
+		return
+	}
+	newApp := adapter.CamelApp(ctx, c)
+	newApp.Name = appName
+	var sources []string
+	for _, workload := range workloads[1:] {
+		sources = append(sources, getWorkloadSource(workload))
+	}
+	setAggregatedSources(newApp, sources)
+	if err := createSyntheticCamelApp(ctx, c, newApp); err != nil {
+		log.Errorf(err, "Some error happened while creating a synthetic Camel Application %s", appName)
+		return
+	}
+	log.Infof("Created a synthetic Camel Application %s after %s resource object", appName, workloads[0].GetName())
+}
+
+// findSyntheticCamelApp returns the synthetic Camel Application imported from the workload, if any, considering the
+// name it may have been suffixed with on a name conflict.
+func findSyntheticCamelApp(ctx context.Context, c client.Client, ctrlObj ctrl.Object) (*v1alpha1.CamelApp, error) {
+	appName := getAppName(ctrlObj)
+	for _, name := range []string{appName, suffixedAppName(appName, GetWorkloadKind(ctrlObj), ctrlObj.GetName())} {
+		app, err := getSyntheticCamelApp(ctx, c, ctrlObj.GetNamespace(), name)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if getAppSource(app) == getWorkloadSource(ctrlObj) {
+			return app, nil
+		}
+	}
+
+	return nil, nil
 }
 
 func getInformers(ctx context.Context, cl client.Client, c cache.Cache) ([]cache.Informer, error) {
@@ -116,8 +329,9 @@ func getInformers(ctx context.Context, cl client.Client, c cache.Cache) ([]cache
 		return nil, err
 	}
 	informers := []cache.Informer{deploy}
+	watchesCronJobs, watchesKnativeServices := discoverWorkloadKinds(ctx, cl)
 	// Watch for the CronJob conditionally
-	if ok, err := kubernetes.IsAPIResourceInstalled(cl, batchv1.SchemeGroupVersion.String(), reflect.TypeOf(batchv1.CronJob{}).Name()); ok && err == nil {
+	if watchesCronJobs {
 		cron, err := c.GetInformer(ctx, &batchv1.CronJob{})
 		if err != nil {
 			return nil, err
@@ -125,19 +339,114 @@ func getInformers(ctx context.Context, cl client.Client, c cache.Cache) ([]cache
 		informers = append(informers, cron)
 	}
 	// Watch for the Knative Services conditionally
-	if ok, err := kubernetes.IsAPIResourceInstalled(cl, servingv1.SchemeGroupVersion.String(), reflect.TypeOf(servingv1.Service{}).Name()); ok && err == nil {
-		if ok, err := kubernetes.CheckPermission(ctx, cl, serving.GroupName, "services", platform.GetOperatorWatchNamespace(), "", "watch"); ok && err == nil {
-			ksvc, err := c.GetInformer(ctx, &servingv1.Service{})
-			if err != nil {
-				return nil, err
-			}
-			informers = append(informers, ksvc)
+	if watchesKnativeServices {
+		ksvc, err := c.GetInformer(ctx, &servingv1.Service{})
+		if err != nil {
+			return nil, err
 		}
+		informers = append(informers, ksvc)
 	}
 
 	return informers, nil
 }
 
+// discoverWorkloadKinds returns whether the CronJobs and the Knative Services can be imported. They are discovered once.
+func discoverWorkloadKinds(ctx context.Context, cl client.Client) (bool, bool) {
+	workloadKindsOnce.Do(func() {
+		if ok, err := kubernetes.IsAPIResourceInstalled(cl, batchv1.SchemeGroupVersion.String(), reflect.TypeOf(batchv1.CronJob{}).Name()); ok && err == nil {
+			cronJobsImported = true
+		}
+		if ok, err := kubernetes.IsAPIResourceInstalled(cl, servingv1.SchemeGroupVersion.String(), reflect.TypeOf(servingv1.Service{}).Name()); ok && err == nil {
+			knativeServicesImported = canWatchKnativeServices(ctx, cl)
+		}
+	})
+
+	return cronJobsImported, knativeServicesImported
+}
+
+// canWatchKnativeServices returns true if the operator is allowed to watch the Knative Services in all the watched namespaces.
+func canWatchKnativeServices(ctx context.Context, cl client.Client) bool {
+	namespaces := platform.GetOperatorWatchNamespaces()
+	if len(namespaces) == 0 {
+		// Cluster wide permission
+		namespaces = []string{""}
+	}
+	for _, namespace := range namespaces {
+		if ok, err := kubernetes.CheckPermission(ctx, cl, serving.GroupName, "services", namespace, "", "watch"); !ok || err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ImportNamespace imports the Camel applications of a namespace which has just been selected for monitoring.
+func ImportNamespace(ctx context.Context, c client.Client, recorder record.EventRecorder, namespace string) error {
+	workloads, err := listImportedWorkloads(ctx, c, namespace)
+	if err != nil {
+		return err
+	}
+	for _, workload := range workloads {
+		onAdd(ctx, c, recorder, workload)
+	}
+
+	return nil
+}
+
+// listImportedWorkloads returns the workloads of a namespace (all namespaces if empty) which must be imported as
+// synthetic Camel applications.
+func listImportedWorkloads(ctx context.Context, c client.Client, namespace string) ([]ctrl.Object, error) {
+	lists := []ctrl.ObjectList{&appsv1.DeploymentList{}}
+	watchesCronJobs, watchesKnativeServices := discoverWorkloadKinds(ctx, c)
+	if watchesCronJobs {
+		lists = append(lists, &batchv1.CronJobList{})
+	}
+	if watchesKnativeServices {
+		lists = append(lists, &servingv1.ServiceList{})
+	}
+	selector, err := platform.GetAppSelector()
+	if err != nil {
+		return nil, err
+	}
+	opts := []ctrl.ListOption{ctrl.InNamespace(namespace), ctrl.MatchingLabelsSelector{Selector: selector}}
+	var workloads []ctrl.Object
+	for _, list := range lists {
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		err := meta.EachListItem(list, func(obj runtime.Object) error {
+			if ctrlObj, ok := obj.(ctrl.Object); ok && isImported(ctrlObj) {
+				workloads = append(workloads, ctrlObj)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return workloads, nil
+}
+
+// ForgetNamespace deletes the synthetic Camel applications of a namespace which is no longer selected for monitoring.
+func ForgetNamespace(ctx context.Context, c client.Client, namespace string) error {
+	apps := v1alpha1.CamelAppList{}
+	if err := c.List(ctx, &apps, ctrl.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for _, app := range apps.Items {
+		if app.Annotations[v1alpha1.AppSyntheticLabel] != "true" {
+			continue
+		}
+		if err := c.Delete(ctx, &app); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		log.Infof("Deleted synthetic Camel Application %s/%s", app.Namespace, app.Name)
+	}
+
+	return nil
+}
+
 func getSyntheticCamelApp(ctx context.Context, c client.Client, namespace, name string) (*v1alpha1.CamelApp, error) {
 	app := v1alpha1.NewApp(namespace, name)
 	err := c.Get(ctx, ctrl.ObjectKeyFromObject(&app), &app)
@@ -148,12 +457,6 @@ func createSyntheticCamelApp(ctx context.Context, c client.Client, app *v1alpha1
 	return c.Create(ctx, app, ctrl.FieldOwner("camel-dashboard-operator"))
 }
 
-func deleteSyntheticCamelApp(ctx context.Context, c client.Client, namespace, name string) error {
-	// As the Integration label was removed, we don't know which is the Synthetic Camel Application to remove
-	app := v1alpha1.NewApp(namespace, name)
-	return c.Delete(ctx, &app)
-}
-
 // NonManagedCamelApplicationAdapter represents a Camel application built and deployed outside the operator lifecycle.
 type NonManagedCamelApplicationAdapter interface {
 	// CamelApp returns a CamelApp resource fed by the Camel application adapter.
@@ -164,8 +467,10 @@ type NonManagedCamelApplicationAdapter interface {
 	GetAppImage() string
 	// GetReplicas returns the number of desired replicas for the backing Camel application.
 	GetReplicas() *int32
+	// GetPodSelector returns the selector of the Pods backing the Camel application, if any.
+	GetPodSelector() *metav1.LabelSelector
 	// GetPods returns the actual Pods backing the Camel application.
-	GetPods(ctx context.Context, c client.Client) ([]v1alpha1.PodInfo, error)
+	GetPods(ctx context.Context, c client.Client, settings platform.AppSettings) ([]v1alpha1.PodInfo, error)
 	// GetAnnotations returns the backing deployment object annotations.
 	GetAnnotations() map[string]string
 }
@@ -22,6 +22,7 @@ import (
 
 	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -34,7 +35,7 @@ type nonManagedCamelCronjob struct {
 
 // CamelApp return an CamelApp resource fed by the Camel application adapter.
 func (app *nonManagedCamelCronjob) CamelApp(ctx context.Context, c client.Client) *v1alpha1.CamelApp {
-	newApp := v1alpha1.NewApp(app.cron.Namespace, app.cron.Labels[v1alpha1.AppLabel])
+	newApp := v1alpha1.NewApp(app.cron.Namespace, getAppName(app.cron))
 	newApp.SetAnnotations(map[string]string{
 		v1alpha1.AppImportedNameLabel: app.cron.Name,
 		v1alpha1.AppImportedKindLabel: "CronJob",
@@ -63,13 +64,18 @@ func (app *nonManagedCamelCronjob) GetReplicas() *int32 {
 	return ptr.To(int32(-1))
 }
 
+// GetPodSelector returns the selector of the Pods backing the Camel application, if any.
+func (app *nonManagedCamelCronjob) GetPodSelector() *metav1.LabelSelector {
+	return nil
+}
+
 // GetAppImage returns the container image of the backing Camel application.
 func (app *nonManagedCamelCronjob) GetAppImage() string {
 	return ""
 }
 
 // GetPods returns the container image of the backing Camel application.
-func (app *nonManagedCamelCronjob) GetPods(ctx context.Context, c client.Client) ([]v1alpha1.PodInfo, error) {
+func (app *nonManagedCamelCronjob) GetPods(ctx context.Context, c client.Client, settings platform.AppSettings) ([]v1alpha1.PodInfo, error) {
 	return nil, nil
 }
 
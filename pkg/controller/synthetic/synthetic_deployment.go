@@ -24,7 +24,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
@@ -39,7 +38,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 
 	dto "github.com/prometheus/client_model/go"
-	"github.com/prometheus/common/expfmt"
 )
 
 // nonManagedCamelDeployment represents a regular Camel application built and deployed outside the operator lifecycle.
@@ -49,7 +47,7 @@ type nonManagedCamelDeployment struct {
 
 // CamelApp return an CamelApp resource fed by the Camel application adapter.
 func (app *nonManagedCamelDeployment) CamelApp(ctx context.Context, c client.Client) *v1alpha1.CamelApp {
-	newApp := v1alpha1.NewApp(app.deploy.Namespace, app.deploy.Labels[v1alpha1.AppLabel])
+	newApp := v1alpha1.NewApp(app.deploy.Namespace, getAppName(app.deploy))
 	newApp.SetAnnotations(map[string]string{
 		v1alpha1.AppImportedNameLabel: app.deploy.Name,
 		v1alpha1.AppImportedKindLabel: "Deployment",
@@ -92,14 +90,18 @@ func (app *nonManagedCamelDeployment) GetReplicas() *int32 {
 	return app.deploy.Spec.Replicas
 }
 
+// GetPodSelector returns the selector of the Pods backing the Camel application, if any.
+func (app *nonManagedCamelDeployment) GetPodSelector() *metav1.LabelSelector {
+	return app.deploy.Spec.Selector
+}
+
 // GetAnnotations returns the backing deployment object annotations.
 func (app *nonManagedCamelDeployment) GetAnnotations() map[string]string {
 	return app.deploy.Annotations
 }
 
 // GetPods returns the pods backing the Camel application.
-func (app *nonManagedCamelDeployment) GetPods(ctx context.Context, c client.Client) ([]v1alpha1.PodInfo, error) {
-	var podsInfo []v1alpha1.PodInfo
+func (app *nonManagedCamelDeployment) GetPods(ctx context.Context, c client.Client, settings platform.AppSettings) ([]v1alpha1.PodInfo, error) {
 	pods := &corev1.PodList{}
 	err := c.List(ctx, pods,
 		ctrl.InNamespace(app.deploy.GetNamespace()),
@@ -108,8 +110,20 @@ func (app *nonManagedCamelDeployment) GetPods(ctx context.Context, c client.Clie
 	if err != nil {
 		return nil, err
 	}
-	observabilityPort := app.getObservabilityPort()
-	for _, pod := range pods.Items {
+
+	return getPodsInfo(ctx, c, "Deployment", app.deploy.GetNamespace(), app.deploy.GetName(), pods.Items, app.GetAnnotations(), settings), nil
+}
+
+// getPodsInfo returns the information of the pods backing a Camel application, scraping the ready ones.
+func getPodsInfo(ctx context.Context, c client.Client, kind, namespace, name string, pods []corev1.Pod,
+	annotations map[string]string, settings platform.AppSettings) []v1alpha1.PodInfo {
+	var podsInfo []v1alpha1.PodInfo
+	mapping := resolveMetricsMapping(getOperatorMetricsMappingConfig(), getAppMetricsMappingConfig(annotations))
+	config, err := newScrapeConfig(ctx, c, namespace, settings, mapping)
+	if err != nil {
+		log.Errorf(err, "%s %s/%s: Could not load the scrape credentials", kind, namespace, name)
+	}
+	for _, pod := range pods {
 		podIp := pod.Status.PodIP
 		podInfo := v1alpha1.PodInfo{
 			Name:           pod.GetName(),
@@ -123,16 +137,30 @@ func (app *nonManagedCamelDeployment) GetPods(ctx context.Context, c client.Clie
 			podInfo.UptimeTimestamp = &metav1.Time{Time: ready.LastTransitionTime.Time}
 			ready := true
 			podInfo.ObservabilityService = &v1alpha1.ObservabilityServiceInfo{}
-			if err := setHealth(&podInfo, podIp, observabilityPort); err != nil {
+			healthErr := setHealth(&podInfo, podIp, config)
+			metricsErr := setMetrics(&podInfo, podIp, config)
+			// Fallback to Jolokia for those applications not exposing any metrics
+			if metricsErr != nil && podInfo.JolokiaEnabled && settings.JolokiaScrape {
+				if err := setJolokia(&podInfo, podIp, kubernetes.JolokiaPort(pod), config.credentials); err != nil {
+					log.Infof("%s %s/%s: Could not inspect Jolokia endpoint: %s", kind, namespace, name, err.Error())
+				} else {
+					metricsErr = nil
+					// Without a health endpoint, the health is derived from the Camel context state
+					if healthErr != nil && podInfo.Runtime.Status == "UP" {
+						healthErr = nil
+					}
+				}
+			}
+			if healthErr != nil {
 				ready = false
-				reason := fmt.Sprintf("Could not scrape health endpoint: %s", err.Error())
-				log.Infof("Deployment %s/%s: %s", app.deploy.GetNamespace(), app.deploy.GetName(), reason)
+				reason := fmt.Sprintf("Could not scrape health endpoint: %s", healthErr.Error())
+				log.Infof("%s %s/%s: %s", kind, namespace, name, reason)
 				podInfo.Reason = reason
 			}
-			if err := setMetrics(&podInfo, podIp, observabilityPort); err != nil {
+			if metricsErr != nil {
 				ready = false
-				reason := fmt.Sprintf("Could not scrape metrics endpoint: %s", err.Error())
-				log.Infof("Deployment %s/%s: %s", app.deploy.GetNamespace(), app.deploy.GetName(), reason)
+				reason := fmt.Sprintf("Could not scrape metrics endpoint: %s", metricsErr.Error())
+				log.Infof("%s %s/%s: %s", kind, namespace, name, reason)
 				if podInfo.Reason != "" {
 					podInfo.Reason += ". "
 				}
@@ -144,27 +172,26 @@ func (app *nonManagedCamelDeployment) GetPods(ctx context.Context, c client.Clie
 		podsInfo = append(podsInfo, podInfo)
 	}
 
-	return podsInfo, nil
+	return podsInfo
 }
 
-func setMetrics(podInfo *v1alpha1.PodInfo, podIp string, port int) error {
+func setMetrics(podInfo *v1alpha1.PodInfo, podIp string, config scrapeConfig) error {
 	// NOTE: we're not using a proxy as a design choice in order
 	// to have a faster turnaround.
-	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s:%d/%s", podIp, port, platform.DefaultObservabilityMetrics), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s:%d/%s", podIp, config.port, config.metricsEndpoint), nil)
 	if err != nil {
 		return err
 	}
-	// Quarkus runtime specific, see https://github.com/apache/camel-quarkus/issues/7405
-	req.Header.Add("Accept", "text/plain, */*")
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	req.Header.Add("Accept", metricsAcceptHeader)
+	config.credentials.authorize(req)
+	resp, err := scrapeClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode == http.StatusOK {
-		podInfo.ObservabilityService.MetricsEndpoint = platform.DefaultObservabilityMetrics
-		podInfo.ObservabilityService.MetricsPort = port
+		podInfo.ObservabilityService.MetricsEndpoint = config.metricsEndpoint
+		podInfo.ObservabilityService.MetricsPort = config.port
 
 		if podInfo.Runtime == nil {
 			podInfo.Runtime = &v1alpha1.RuntimeInfo{}
@@ -173,28 +200,11 @@ func setMetrics(podInfo *v1alpha1.PodInfo, podIp string, port int) error {
 			podInfo.Runtime.Exchange = &v1alpha1.ExchangeInfo{}
 		}
 
-		metrics, err := parseMetrics(resp.Body)
+		metrics, err := parseMetrics(resp.Body, resp.Header.Get("Content-Type"))
 		if err != nil {
 			return err
 		}
-		if metric, ok := metrics["app_info"]; ok {
-			populateRuntimeInfo(metric, "app_info", podInfo)
-		}
-		if metric, ok := metrics["camel_exchanges_last_timestamp"]; ok {
-			populateExchangesLastTimestamp(metric, "camel_exchanges_last_timestamp", podInfo)
-		}
-		if metric, ok := metrics["camel_exchanges_total"]; ok {
-			populateExchangesTotal(metric, "camel_exchanges_total", podInfo)
-		}
-		if metric, ok := metrics["camel_exchanges_failed_total"]; ok {
-			populateExchangesFailedTotal(metric, "camel_exchanges_failed_total", podInfo)
-		}
-		if metric, ok := metrics["camel_exchanges_succeeded_total"]; ok {
-			populateExchangesSucceededTotal(metric, "camel_exchanges_succeeded_total", podInfo)
-		}
-		if metric, ok := metrics["camel_exchanges_inflight"]; ok {
-			populateExchangesInflight(metric, "camel_exchanges_inflight", podInfo)
-		}
+		populateMetrics(metrics, config.mapping, podInfo)
 
 		return nil
 	}
@@ -202,14 +212,27 @@ func setMetrics(podInfo *v1alpha1.PodInfo, podIp string, port int) error {
 	return fmt.Errorf("HTTP status not OK, it was %d", resp.StatusCode)
 }
 
-func parseMetrics(reader io.Reader) (map[string]*dto.MetricFamily, error) {
-	var parser expfmt.TextParser
-	mf, err := parser.TextToMetricFamilies(reader)
-	if err != nil {
-		return nil, err
+// populateMetrics collects the runtime information from the metrics, looking up each metric by its mapped name.
+func populateMetrics(metrics map[string]*dto.MetricFamily, mapping metricsMapping, podInfo *v1alpha1.PodInfo) {
+	if name := mapping.name(metricAppInfo); metrics[name] != nil {
+		populateRuntimeInfo(metrics[name], name, podInfo)
 	}
-
-	return mf, nil
+	if name := mapping.name(metricExchangesLastTimestamp); metrics[name] != nil {
+		populateExchangesLastTimestamp(metrics[name], name, podInfo)
+	}
+	if name := mapping.name(metricExchangesTotal); metrics[name] != nil {
+		populateExchangesTotal(metrics[name], name, podInfo)
+	}
+	if name := mapping.name(metricExchangesFailedTotal); metrics[name] != nil {
+		populateExchangesFailedTotal(metrics[name], name, podInfo)
+	}
+	if name := mapping.name(metricExchangesSucceededTotal); metrics[name] != nil {
+		populateExchangesSucceededTotal(metrics[name], name, podInfo)
+	}
+	if name := mapping.name(metricExchangesInflight); metrics[name] != nil {
+		populateExchangesInflight(metrics[name], name, podInfo)
+	}
+	populateRoutes(metrics, mapping, podInfo)
 }
 
 func populateRuntimeInfo(metric *dto.MetricFamily, metricName string, podInfo *v1alpha1.PodInfo) {
@@ -282,6 +305,58 @@ func populateExchangesInflight(metric *dto.MetricFamily, metricName string, podI
 	podInfo.Runtime.Exchange.Pending = int(ptr.Deref(metric.GetMetric()[0].GetGauge().Value, 0))
 }
 
+// populateRoutes collects the routes information from the routes and running routes gauges. When the total number
+// of routes is not exposed, it is inferred from the per route exchanges metrics. The metrics do not tell the suspended
+// routes from the stopped ones, which are left unset: only the routes inspected via Jolokia report them.
+func populateRoutes(metrics map[string]*dto.MetricFamily, mapping metricsMapping, podInfo *v1alpha1.PodInfo) {
+	total, hasTotal := getGaugeValue(metrics[mapping.name(metricRoutes)], mapping.name(metricRoutes))
+	running, hasRunning := getGaugeValue(metrics[mapping.name(metricRoutesRunning)], mapping.name(metricRoutesRunning))
+	if !hasTotal {
+		total = countRoutes(metrics[mapping.name(metricExchangesTotal)])
+	}
+	if !hasTotal && !hasRunning && total == 0 {
+		return
+	}
+	if total < running {
+		total = running
+	}
+
+	podInfo.Runtime.Routes = &v1alpha1.RoutesInfo{
+		Total:   total,
+		Started: running,
+	}
+}
+
+// getGaugeValue returns the value of the first gauge of a metric family, if any.
+func getGaugeValue(metric *dto.MetricFamily, metricName string) (int, bool) {
+	if metric == nil || len(metric.GetMetric()) == 0 {
+		return 0, false
+	}
+	if metric.GetMetric()[0].GetGauge() == nil {
+		log.Infof("WARN: expected %s metric to be a gauge", metricName)
+		return 0, false
+	}
+
+	return int(ptr.Deref(metric.GetMetric()[0].GetGauge().Value, 0)), true
+}
+
+// countRoutes returns the number of distinct routes reported by the routeId label of a metric family.
+func countRoutes(metric *dto.MetricFamily) int {
+	if metric == nil {
+		return 0
+	}
+	routes := map[string]bool{}
+	for _, m := range metric.GetMetric() {
+		for _, label := range m.GetLabel() {
+			if ptr.Deref(label.Name, "") == "routeId" && ptr.Deref(label.Value, "") != "" {
+				routes[ptr.Deref(label.Value, "")] = true
+			}
+		}
+	}
+
+	return len(routes)
+}
+
 func populateExchangesLastTimestamp(metric *dto.MetricFamily, metricName string, podInfo *v1alpha1.PodInfo) {
 	if len(metric.GetMetric()) == 0 {
 		log.Debugf("expected at least 1 exchanges_last_timestamp metric, got %d", len(metric.GetMetric()))
@@ -300,10 +375,15 @@ func populateExchangesLastTimestamp(metric *dto.MetricFamily, metricName string,
 	podInfo.Runtime.Exchange.LastTimestamp = &metav1.Time{Time: timeUnixMilli}
 }
 
-func setHealth(podInfo *v1alpha1.PodInfo, podIp string, port int) error {
+func setHealth(podInfo *v1alpha1.PodInfo, podIp string, config scrapeConfig) error {
 	// NOTE: we're not using a proxy as a design choice in order
 	// to have a faster turnaround.
-	resp, err := http.Get(fmt.Sprintf("http://%s:%d/%s", podIp, port, platform.DefaultObservabilityHealth))
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://%s:%d/%s", podIp, config.port, config.healthEndpoint), nil)
+	if err != nil {
+		return err
+	}
+	config.credentials.authorize(req)
+	resp, err := scrapeClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -312,8 +392,8 @@ func setHealth(podInfo *v1alpha1.PodInfo, podIp string, port int) error {
 	// The endpoint reports 503 when the service is down, but still provide the
 	// health information
 	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusServiceUnavailable {
-		podInfo.ObservabilityService.HealthPort = port
-		podInfo.ObservabilityService.HealthEndpoint = platform.DefaultObservabilityHealth
+		podInfo.ObservabilityService.HealthPort = config.port
+		podInfo.ObservabilityService.HealthEndpoint = config.healthEndpoint
 
 		status, err = parseHealthStatus(resp.Body)
 		if err != nil {
@@ -341,20 +421,3 @@ func parseHealthStatus(reader io.Reader) (string, error) {
 
 	return string(status), nil
 }
-
-func (app *nonManagedCamelDeployment) getObservabilityPort() int {
-	defaultPort := platform.GetObservabilityPort()
-	if app.GetAnnotations() == nil || app.GetAnnotations()[v1alpha1.AppObservabilityServicesPort] == "" {
-		return defaultPort
-	}
-
-	port, err := strconv.Atoi(app.GetAnnotations()[v1alpha1.AppObservabilityServicesPort])
-	if err == nil {
-		return port
-	} else {
-		log.Error(err, "could not properly parse application observability services port configuration, "+
-			"fallback to default operator value %d", defaultPort)
-	}
-
-	return defaultPort
-}
@@ -0,0 +1,73 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestPopulateRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    *v1.RoutesInfo
+	}{
+		{
+			name: "routes gauges",
+			payload: `# TYPE camel_routes gauge
+camel_routes{camelContext="ctx"} 3.0
+# TYPE camel_routes_running gauge
+camel_routes_running{camelContext="ctx"} 2.0
+`,
+			want: &v1.RoutesInfo{Total: 3, Started: 2},
+		},
+		{
+			name: "total inferred from per route metrics",
+			payload: `# TYPE camel_exchanges_total counter
+camel_exchanges_total{routeId="route1"} 10.0
+camel_exchanges_total{routeId="route2"} 5.0
+# TYPE camel_routes_running gauge
+camel_routes_running{camelContext="ctx"} 1.0
+`,
+			want: &v1.RoutesInfo{Total: 2, Started: 1},
+		},
+		{
+			name: "no routes metrics",
+			payload: `# TYPE camel_exchanges_total counter
+camel_exchanges_total{camelContext="ctx"} 10.0
+`,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics, err := parseMetrics(strings.NewReader(tt.payload), "text/plain; version=0.0.4")
+			require.NoError(t, err)
+			podInfo := v1.PodInfo{Runtime: &v1.RuntimeInfo{}}
+			populateRoutes(metrics, defaultMetricsMapping, &podInfo)
+			assert.Equal(t, tt.want, podInfo.Runtime.Routes)
+		})
+	}
+}
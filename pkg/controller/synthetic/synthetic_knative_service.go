@@ -22,6 +22,7 @@ import (
 
 	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -34,7 +35,7 @@ type nonManagedCamelKnativeService struct {
 
 // CamelApp return an CamelApp resource fed by the Camel application adapter.
 func (app *nonManagedCamelKnativeService) CamelApp(ctx context.Context, c client.Client) *v1alpha1.CamelApp {
-	newApp := v1alpha1.NewApp(app.ksvc.Namespace, app.ksvc.Labels[v1alpha1.AppLabel])
+	newApp := v1alpha1.NewApp(app.ksvc.Namespace, getAppName(app.ksvc))
 	newApp.SetAnnotations(map[string]string{
 		v1alpha1.AppImportedNameLabel: app.ksvc.Name,
 		v1alpha1.AppImportedKindLabel: "KnativeService",
@@ -63,13 +64,18 @@ func (app *nonManagedCamelKnativeService) GetReplicas() *int32 {
 	return ptr.To(int32(-1))
 }
 
+// GetPodSelector returns the selector of the Pods backing the Camel application, if any.
+func (app *nonManagedCamelKnativeService) GetPodSelector() *metav1.LabelSelector {
+	return nil
+}
+
 // GetAppImage returns the container image of the backing Camel application.
 func (app *nonManagedCamelKnativeService) GetAppImage() string {
 	return ""
 }
 
 // GetPods returns the container image of the backing Camel application.
-func (app *nonManagedCamelKnativeService) GetPods(ctx context.Context, c client.Client) ([]v1alpha1.PodInfo, error) {
+func (app *nonManagedCamelKnativeService) GetPods(ctx context.Context, c client.Client, settings platform.AppSettings) ([]v1alpha1.PodInfo, error) {
 	return nil, nil
 }
 
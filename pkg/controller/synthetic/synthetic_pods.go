@@ -0,0 +1,102 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+
+	v1alpha1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nonManagedCamelPods represents a Camel application declared by a selector of the Pods backing it, as it is deployed
+// without any workload the operator knows about.
+type nonManagedCamelPods struct {
+	app  *v1alpha1.CamelApp
+	pods []corev1.Pod
+}
+
+// NewNonManagedCamelPods returns the adapter of a Camel application backed by the Pods matching its spec selector.
+func NewNonManagedCamelPods(ctx context.Context, c ctrl.Reader, app *v1alpha1.CamelApp) (NonManagedCamelApplicationAdapter, error) {
+	selector, err := metav1.LabelSelectorAsSelector(app.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, ctrl.InNamespace(app.Namespace), ctrl.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	return &nonManagedCamelPods{app: app, pods: pods.Items}, nil
+}
+
+// CamelApp return an CamelApp resource fed by the Camel application adapter.
+func (app *nonManagedCamelPods) CamelApp(ctx context.Context, c client.Client) *v1alpha1.CamelApp {
+	return app.app.DeepCopy()
+}
+
+// GetAppPhase returns the phase of the backing Camel application.
+func (app *nonManagedCamelPods) GetAppPhase() v1alpha1.CamelAppPhase {
+	if len(app.pods) == 0 {
+		return v1alpha1.CamelAppPhasePaused
+	}
+	for _, pod := range app.pods {
+		if ready := kubernetes.GetPodCondition(pod, corev1.PodReady); ready == nil || ready.Status != corev1.ConditionTrue {
+			return v1alpha1.CamelAppPhaseError
+		}
+	}
+
+	return v1alpha1.CamelAppPhaseRunning
+}
+
+// GetReplicas returns the number of desired replicas for the backing Camel application.
+func (app *nonManagedCamelPods) GetReplicas() *int32 {
+	return ptr.To(int32(len(app.pods)))
+}
+
+// GetPodSelector returns the selector of the Pods backing the Camel application, if any.
+func (app *nonManagedCamelPods) GetPodSelector() *metav1.LabelSelector {
+	return app.app.Spec.Selector
+}
+
+// GetAppImage returns the container image of the backing Camel application.
+func (app *nonManagedCamelPods) GetAppImage() string {
+	for _, pod := range app.pods {
+		if len(pod.Spec.Containers) > 0 {
+			return pod.Spec.Containers[0].Image
+		}
+	}
+
+	return ""
+}
+
+// GetPods returns the pods backing the Camel application.
+func (app *nonManagedCamelPods) GetPods(ctx context.Context, c client.Client, settings platform.AppSettings) ([]v1alpha1.PodInfo, error) {
+	return getPodsInfo(ctx, c, "Pods", app.app.Namespace, app.app.Name, app.pods, app.GetAnnotations(), settings), nil
+}
+
+// GetAnnotations returns the backing Camel application annotations, as there is no workload.
+func (app *nonManagedCamelPods) GetAnnotations() map[string]string {
+	return app.app.Annotations
+}
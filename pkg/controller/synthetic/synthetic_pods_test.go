@@ -0,0 +1,76 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synthetic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func newPod(name string, labels map[string]string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+			Labels:    labels,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "my-cnt", Image: "my-img"}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func TestNonManagedCamelPods(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		newPod("my-pod-1", map[string]string{"app": "my-vendor-app"}, corev1.ConditionTrue),
+		newPod("my-pod-2", map[string]string{"app": "my-vendor-app"}, corev1.ConditionTrue),
+		newPod("my-other-pod", map[string]string{"app": "my-other-app"}, corev1.ConditionFalse),
+	).Build()
+	app := v1.NewApp("ns", "my-app")
+	app.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-vendor-app"}}
+
+	adapter, err := NewNonManagedCamelPods(context.TODO(), c, &app)
+	require.NoError(t, err)
+	assert.Equal(t, v1.CamelAppPhaseRunning, adapter.GetAppPhase())
+	assert.Equal(t, ptr.To(int32(2)), adapter.GetReplicas())
+	assert.Equal(t, "my-img", adapter.GetAppImage())
+
+	app.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-other-app"}}
+	adapter, err = NewNonManagedCamelPods(context.TODO(), c, &app)
+	require.NoError(t, err)
+	assert.Equal(t, v1.CamelAppPhaseError, adapter.GetAppPhase())
+
+	app.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-missing-app"}}
+	adapter, err = NewNonManagedCamelPods(context.TODO(), c, &app)
+	require.NoError(t, err)
+	assert.Equal(t, v1.CamelAppPhasePaused, adapter.GetAppPhase())
+	assert.Equal(t, "", adapter.GetAppImage())
+}
@@ -243,3 +243,48 @@ func TestNonManagedKnativeService(t *testing.T) {
 	assert.NotNil(t, knativeServiceAdapter)
 	assert.Equal(t, expectedIt, *knativeServiceAdapter.CamelApp(context.Background(), nil))
 }
+
+func TestSyncCamelAppMetadata(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-deploy",
+			UID:       "uid-2",
+			Labels: map[string]string{
+				v1.AppLabel: "my-app",
+			},
+			Annotations: map[string]string{
+				v1.AppPollingIntervalSecondsAnnotation: "10",
+				"my.domain/annotation":                 "value",
+			},
+		},
+	}
+	adapter, err := NonManagedCamelApplicationFactory(deploy)
+	require.NoError(t, err)
+	expected := adapter.CamelApp(context.TODO(), nil)
+
+	app := v1.NewApp("ns", "my-app")
+	app.Annotations = map[string]string{
+		v1.AppImportedNameLabel:                            "my-deploy",
+		v1.AppImportedKindLabel:                            "Deployment",
+		v1.AppSyntheticLabel:                               "true",
+		v1.AppSLIExchangeErrorPercentageAnnotation:         "2",
+		v1.AppAggregatedSourcesAnnotation:                  "CronJob/my-cron",
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	}
+	app.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "my-deploy", UID: "uid-1"}}
+
+	assert.True(t, syncCamelAppMetadata(&app, expected))
+	assert.Equal(t, map[string]string{
+		v1.AppImportedNameLabel:                            "my-deploy",
+		v1.AppImportedKindLabel:                            "Deployment",
+		v1.AppSyntheticLabel:                               "true",
+		v1.AppPollingIntervalSecondsAnnotation:             "10",
+		v1.AppAggregatedSourcesAnnotation:                  "CronJob/my-cron",
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	}, app.Annotations)
+	require.Len(t, app.OwnerReferences, 1)
+	assert.Equal(t, "uid-2", string(app.OwnerReferences[0].UID))
+
+	assert.False(t, syncCamelAppMetadata(&app, expected))
+}
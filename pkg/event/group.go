@@ -0,0 +1,113 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+const (
+	reasonMemberDegraded  = "MemberDegraded"
+	reasonMemberRecovered = "MemberRecovered"
+)
+
+// NotifyAppGroupTransitions generates events on the group when one of its Apps degrades (it fails, becomes unhealthy
+// or its exchange SLI status worsens), and when a degraded App recovers. A change to the state last emitted for the App
+// is always emitted, while a repeat of the same state is emitted at most once per de-duplication window.
+func NotifyAppGroupTransitions(recorder record.EventRecorder, old, newResource *v1alpha1.CamelAppGroup) {
+	if old == nil || newResource == nil {
+		return
+	}
+	notifyGroupTransitions(recorder, transitions, platform.GetEventDedupWindow(), old, newResource)
+}
+
+func notifyGroupTransitions(recorder record.EventRecorder, filter *transitionFilter, window time.Duration, old, newResource *v1alpha1.CamelAppGroup) {
+	previous := make(map[string]v1alpha1.AppGroupMember, len(old.Status.Members))
+	for _, member := range old.Status.Members {
+		previous[member.Namespace+"/"+member.Name] = member
+	}
+
+	for _, member := range newResource.Status.Members {
+		app := member.Namespace + "/" + member.Name
+		was, found := previous[app]
+		if !found {
+			// A new member has not degraded
+			continue
+		}
+		oldIssues, newIssues := getMemberIssues(was), getMemberIssues(member)
+		var degraded []string
+		for _, issue := range newIssues {
+			if !slices.Contains(oldIssues, issue) {
+				degraded = append(degraded, issue)
+			}
+		}
+		if getSLIRank(member.SLIStatus) > getSLIRank(was.SLIStatus) {
+			degraded = append(degraded, fmt.Sprintf("exchange SLI status changed from %q to %q", was.SLIStatus, member.SLIStatus))
+		}
+		recovered := len(oldIssues) > 0 || getSLIRank(was.SLIStatus) > 0
+
+		var eventType, reason, message string
+		switch {
+		case len(degraded) > 0:
+			eventType, reason = corev1.EventTypeWarning, reasonMemberDegraded
+			message = fmt.Sprintf("Group App %q degraded: %s", app, strings.Join(degraded, ", "))
+		case recovered && len(newIssues) == 0 && getSLIRank(member.SLIStatus) == 0:
+			eventType, reason = corev1.EventTypeNormal, reasonMemberRecovered
+			message = fmt.Sprintf("Group App %q recovered", app)
+		default:
+			continue
+		}
+		key := strings.Join([]string{newResource.Namespace, newResource.Name, app}, "/")
+		if filter.allow(key, reason+"/"+strings.Join(degraded, ","), window) {
+			recorder.Event(newResource, eventType, reason, message)
+		}
+	}
+}
+
+// getMemberIssues returns the issues of a group member, but its exchange SLI status.
+func getMemberIssues(member v1alpha1.AppGroupMember) []string {
+	var issues []string
+	if member.Phase == v1alpha1.CamelAppPhaseError {
+		issues = append(issues, "phase is Error")
+	}
+	if !member.Healthy {
+		issues = append(issues, "is unhealthy")
+	}
+	return issues
+}
+
+// getSLIRank ranks the exchange SLI status, 0 meaning it is not degraded.
+func getSLIRank(status v1alpha1.SLIExchangeStatus) int {
+	switch status {
+	case v1alpha1.SLIExchangeStatusError:
+		return 2
+	case v1alpha1.SLIExchangeStatusWarning:
+		return 1
+	default:
+		return 0
+	}
+}
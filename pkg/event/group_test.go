@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func newTransitionGroup(members ...v1alpha1.AppGroupMember) *v1alpha1.CamelAppGroup {
+	return &v1alpha1.CamelAppGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-group"},
+		Status:     v1alpha1.CamelAppGroupStatus{Members: members},
+	}
+}
+
+func newGroupMember(name string, healthy bool, sliStatus v1alpha1.SLIExchangeStatus) v1alpha1.AppGroupMember {
+	return v1alpha1.AppGroupMember{Namespace: "ns", Name: name, Phase: v1alpha1.CamelAppPhaseRunning, Healthy: healthy, SLIStatus: sliStatus}
+}
+
+func TestNotifyGroupTransitions(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	ok := newGroupMember("app", true, v1alpha1.SLIExchangeStatusSuccess)
+	other := newGroupMember("other", true, v1alpha1.SLIExchangeStatusSuccess)
+
+	// A new member does not degrade the group
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(other),
+		newTransitionGroup(newGroupMember("app", false, v1alpha1.SLIExchangeStatusError), other))
+	requireNoEvent(t, recorder)
+
+	warning := newGroupMember("app", true, v1alpha1.SLIExchangeStatusWarning)
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(ok, other), newTransitionGroup(warning, other))
+	evt := requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" MemberDegraded"), evt)
+	assert.Contains(t, evt, `"ns/app" degraded: exchange SLI status changed from "Success" to "Warning"`)
+
+	// An improving SLI is not a degradation, nor a recovery while the member is still degraded
+	notifyGroupTransitions(recorder, filter, time.Minute,
+		newTransitionGroup(newGroupMember("app", true, v1alpha1.SLIExchangeStatusError)), newTransitionGroup(warning))
+	requireNoEvent(t, recorder)
+
+	failing := newGroupMember("app", false, v1alpha1.SLIExchangeStatusWarning)
+	failing.Phase = v1alpha1.CamelAppPhaseError
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(warning), newTransitionGroup(failing))
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, "degraded: phase is Error, is unhealthy")
+
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(failing), newTransitionGroup(ok))
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeNormal+" MemberRecovered"), evt)
+
+	// Degrading again within the window is emitted, as the last emitted state is the recovery
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(ok), newTransitionGroup(warning))
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" MemberDegraded"), evt)
+
+	// Repeating the same degradation within the window is de-duplicated
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(ok), newTransitionGroup(warning))
+	requireNoEvent(t, recorder)
+	now = now.Add(2 * time.Minute)
+	notifyGroupTransitions(recorder, filter, time.Minute, newTransitionGroup(ok), newTransitionGroup(warning))
+	requireEvent(t, recorder)
+}
@@ -19,6 +19,7 @@ package event
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
@@ -53,6 +54,17 @@ func NotifyAppUpdated(ctx context.Context, c client.Client, recorder record.Even
 		"AppUpdated", "")
 }
 
+// NotifyAppNameConflict generates a warning event on a workload whose Camel application name is already claimed by
+// another workload. The importedAs name is the name the workload was imported with instead, if any.
+func NotifyAppNameConflict(recorder record.EventRecorder, workload ctrl.Object, appName, claimedBy, importedAs string) {
+	info := ""
+	if importedAs != "" {
+		info = fmt.Sprintf(", imported as %q", importedAs)
+	}
+	recorder.Eventf(workload, corev1.EventTypeWarning, "AppNameConflict", "Camel application name %q already claimed by %s%s",
+		appName, claimedBy, info)
+}
+
 func notifyIfPhaseUpdated(ctx context.Context, c client.Client, recorder record.EventRecorder, newResource ctrl.Object,
 	oldPhase, newPhase string, resourceType, name, reason, info string) {
 	if oldPhase == newPhase {
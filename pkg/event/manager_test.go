@@ -24,6 +24,7 @@ import (
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
@@ -218,3 +219,22 @@ func TestNotifyIfPhaseUpdated_EmptyPhase(t *testing.T) {
 		t.Errorf("expected empty phase to be rendered as [none], got: %s", evt)
 	}
 }
+
+func TestNotifyAppNameConflict(t *testing.T) {
+	recorder := record.NewFakeRecorder(2)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-other-deploy"},
+	}
+
+	NotifyAppNameConflict(recorder, deploy, "my-app", "Deployment/my-deploy", "")
+	evt := requireEvent(t, recorder)
+	if evt != `Warning AppNameConflict Camel application name "my-app" already claimed by Deployment/my-deploy` {
+		t.Errorf("unexpected event: %s", evt)
+	}
+
+	NotifyAppNameConflict(recorder, deploy, "my-app", "Deployment/my-deploy", "my-app-deployment-my-other-deploy")
+	evt = requireEvent(t, recorder)
+	if !strings.HasSuffix(evt, `, imported as "my-app-deployment-my-other-deploy"`) {
+		t.Errorf("unexpected event: %s", evt)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/notification"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+const (
+	reasonSLIStatusChanged = "SLIStatusChanged"
+	reasonAppHealthy       = "AppHealthy"
+	reasonAppUnhealthy     = "AppUnhealthy"
+	reasonPodsReady        = "PodsReady"
+	reasonPodsNotReady     = "PodsNotReady"
+
+	// The transitions whose last emitted state is tracked by the de-duplication
+	transitionSLIStatus = "sli"
+	transitionHealth    = "health"
+	transitionPods      = "pods"
+)
+
+// transitionFilter de-duplicates the transition events: the last state emitted for each App transition is tracked, so
+// that a change to a different state is always emitted, while the repeats of the same state are not emitted again until
+// the de-duplication window has elapsed. A flapping App therefore does not flood the event stream with the same event,
+// but none of its degradations is hidden.
+type transitionFilter struct {
+	lock sync.Mutex
+	last map[string]emittedState
+	now  func() time.Time
+}
+
+// emittedState is the last state emitted for a transition, and when it was emitted.
+type emittedState struct {
+	state   string
+	emitted time.Time
+}
+
+var transitions = &transitionFilter{
+	last: map[string]emittedState{},
+	now:  time.Now,
+}
+
+func (f *transitionFilter) allow(key, state string, window time.Duration) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	now := f.now()
+	for k, last := range f.last {
+		if now.Sub(last.emitted) >= window {
+			delete(f.last, k)
+		}
+	}
+	if last, found := f.last[key]; found && last.state == state {
+		return false
+	}
+	f.last[key] = emittedState{state: state, emitted: now}
+
+	return true
+}
+
+// NotifyAppTransitions generates events when the SLI exchange status of the app changes, when its Healthy condition
+// flips, and when some of its pods become unready or all of them are ready again. A change to the same state is emitted
+// at most once per de-duplication window. The emitted events are also notified to the webhooks of the app namespace.
+func NotifyAppTransitions(ctx context.Context, c client.Client, recorder record.EventRecorder, old, newResource *v1alpha1.CamelApp) {
+	if old == nil || newResource == nil {
+		return
+	}
+	notifyTransitions(recorder, transitions, platform.GetEventDedupWindow(), old, newResource,
+		func(eventType, reason, message string) {
+			notification.Notify(ctx, c, newResource, eventType, reason, message)
+		})
+}
+
+func notifyTransitions(recorder record.EventRecorder, filter *transitionFilter, window time.Duration, old, newResource *v1alpha1.CamelApp,
+	notify func(eventType, reason, message string)) {
+	eventf := func(eventType, transition, reason, state, messageFmt string, args ...interface{}) {
+		key := strings.Join([]string{newResource.Namespace, newResource.Name, transition}, "/")
+		if filter.allow(key, reason+"/"+state, window) {
+			message := fmt.Sprintf(messageFmt, args...)
+			recorder.Event(newResource, eventType, reason, message)
+			if notify != nil {
+				notify(eventType, reason, message)
+			}
+		}
+	}
+
+	if oldStatus, newStatus := getSLIStatus(old), getSLIStatus(newResource); newStatus != "" && oldStatus != newStatus {
+		eventType := corev1.EventTypeWarning
+		if newStatus == v1alpha1.SLIExchangeStatusSuccess {
+			eventType = corev1.EventTypeNormal
+		}
+		from := string(oldStatus)
+		if from == "" {
+			from = "[none]"
+		}
+		eventf(eventType, transitionSLIStatus, reasonSLIStatusChanged, string(newStatus), "App %q exchange SLI status changed from %q to %q (%s%% success)",
+			newResource.Name, from, newStatus, newResource.Status.SuccessRate.SuccessPercentage)
+	}
+
+	if healthy := newResource.Status.GetCondition(v1alpha1.AppConditionHealthy); healthy != nil {
+		wasHealthy := old.Status.GetCondition(v1alpha1.AppConditionHealthy)
+		switch {
+		case healthy.Status == metav1.ConditionFalse && (wasHealthy == nil || wasHealthy.Status != metav1.ConditionFalse):
+			eventf(corev1.EventTypeWarning, transitionHealth, reasonAppUnhealthy, "", "App %q is unhealthy: %s", newResource.Name, healthy.Message)
+		case healthy.Status == metav1.ConditionTrue && wasHealthy != nil && wasHealthy.Status != metav1.ConditionTrue:
+			eventf(corev1.EventTypeNormal, transitionHealth, reasonAppHealthy, "", "App %q is healthy again", newResource.Name)
+		}
+	}
+
+	oldUnready, newUnready := getUnreadyPods(old), getUnreadyPods(newResource)
+	var turnedUnready []string
+	for _, pod := range newUnready {
+		if !slices.Contains(oldUnready, pod) {
+			turnedUnready = append(turnedUnready, pod)
+		}
+	}
+	switch {
+	case len(turnedUnready) > 0:
+		eventf(corev1.EventTypeWarning, transitionPods, reasonPodsNotReady, strings.Join(turnedUnready, ","), "App %q pods not ready: %s",
+			newResource.Name, strings.Join(turnedUnready, ", "))
+	case len(newUnready) == 0 && len(oldUnready) > 0 && len(newResource.Status.Pods) > 0:
+		eventf(corev1.EventTypeNormal, transitionPods, reasonPodsReady, "", "App %q pods are all ready", newResource.Name)
+	}
+}
+
+func getSLIStatus(app *v1alpha1.CamelApp) v1alpha1.SLIExchangeStatus {
+	if app.Status.SuccessRate == nil {
+		return ""
+	}
+	return app.Status.SuccessRate.Status
+}
+
+func getUnreadyPods(app *v1alpha1.CamelApp) []string {
+	var pods []string
+	for _, pod := range app.Status.Pods {
+		if !pod.Ready {
+			pods = append(pods, pod.Name)
+		}
+	}
+	return pods
+}
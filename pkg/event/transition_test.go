@@ -0,0 +1,236 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func newTestTransitionFilter(now *time.Time) *transitionFilter {
+	return &transitionFilter{
+		last: map[string]emittedState{},
+		now:  func() time.Time { return *now },
+	}
+}
+
+func TestNotifyTransitionsSLIStatus(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	success := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusSuccess},
+		},
+	}
+	warning := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusWarning},
+		},
+	}
+	failing := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusError},
+		},
+	}
+
+	notifyTransitions(recorder, filter, time.Minute, success, warning, nil)
+	evt := requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" SLIStatusChanged"), evt)
+	assert.Contains(t, evt, `from "Success" to "Warning"`)
+
+	notifyTransitions(recorder, filter, time.Minute, warning, success, nil)
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeNormal+" SLIStatusChanged"), evt)
+
+	// Degrading again within the window is emitted, as the last emitted status is Success
+	notifyTransitions(recorder, filter, time.Minute, success, warning, nil)
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, `from "Success" to "Warning"`)
+
+	// Repeating the same status within the window is de-duplicated
+	notifyTransitions(recorder, filter, time.Minute, failing, warning, nil)
+	requireNoEvent(t, recorder)
+
+	// Same status, no event
+	now = now.Add(2 * time.Minute)
+	notifyTransitions(recorder, filter, time.Minute, warning, warning, nil)
+	requireNoEvent(t, recorder)
+
+	// Once the window has elapsed, the transition is emitted again
+	notifyTransitions(recorder, filter, time.Minute, success, failing, nil)
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, `to "Error"`)
+	notifyTransitions(recorder, filter, time.Minute, failing, warning, nil)
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, `to "Warning"`)
+}
+
+func TestNotifyTransitionsHealth(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	healthy := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Conditions: []metav1.Condition{{Type: "Healthy", Status: metav1.ConditionTrue, Message: "Some pod is not healthy."}},
+		},
+	}
+	unhealthy := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Conditions: []metav1.Condition{{Type: "Healthy", Status: metav1.ConditionFalse, Message: "Some pod is not healthy."}},
+		},
+	}
+
+	notifyTransitions(recorder, filter, time.Minute, healthy, unhealthy, nil)
+	evt := requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" AppUnhealthy"), evt)
+
+	notifyTransitions(recorder, filter, time.Minute, unhealthy, unhealthy, nil)
+	requireNoEvent(t, recorder)
+
+	notifyTransitions(recorder, filter, time.Minute, unhealthy, healthy, nil)
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeNormal+" AppHealthy"), evt)
+
+	// A first healthy reconciliation is not an event
+	notifyTransitions(recorder, filter, time.Minute, &v1alpha1.CamelApp{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"}}, healthy, nil)
+	requireNoEvent(t, recorder)
+}
+
+func TestNotifyTransitionsPods(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	ready := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Pods: []v1alpha1.PodInfo{{Name: "pod-a", Ready: true}, {Name: "pod-b", Ready: true}},
+		},
+	}
+	notReady := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Pods: []v1alpha1.PodInfo{{Name: "pod-a", Ready: true}, {Name: "pod-b", Ready: false}},
+		},
+	}
+
+	notifyTransitions(recorder, filter, time.Minute, ready, notReady, nil)
+	evt := requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" PodsNotReady"), evt)
+	assert.Contains(t, evt, "pod-b")
+
+	notifyTransitions(recorder, filter, time.Minute, notReady, notReady, nil)
+	requireNoEvent(t, recorder)
+
+	notifyTransitions(recorder, filter, time.Minute, notReady, ready, nil)
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeNormal+" PodsReady"), evt)
+}
+
+func TestTransitionFilter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+
+	assert.True(t, filter.allow("ns/my-app/health", "AppUnhealthy/", time.Minute))
+	assert.False(t, filter.allow("ns/my-app/health", "AppUnhealthy/", time.Minute))
+	assert.True(t, filter.allow("ns/other-app/health", "AppUnhealthy/", time.Minute))
+	now = now.Add(time.Minute)
+	assert.True(t, filter.allow("ns/my-app/health", "AppUnhealthy/", time.Minute))
+	assert.Len(t, filter.last, 1)
+}
+
+func TestTransitionFilterStateChanges(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	success := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "99.00", Status: v1alpha1.SLIExchangeStatusSuccess},
+		},
+	}
+	failing := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "50.00", Status: v1alpha1.SLIExchangeStatusError},
+		},
+	}
+
+	// A real degradation is never hidden by a previous one within the window
+	notifyTransitions(recorder, filter, time.Minute, success, failing, nil)
+	evt := requireEvent(t, recorder)
+	assert.Contains(t, evt, `from "Success" to "Error"`)
+	notifyTransitions(recorder, filter, time.Minute, failing, success, nil)
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, `from "Error" to "Success"`)
+	notifyTransitions(recorder, filter, time.Minute, success, failing, nil)
+	evt = requireEvent(t, recorder)
+	assert.Contains(t, evt, `from "Success" to "Error"`)
+
+	// The health transitions are tracked apart from the SLI ones
+	healthy := success.DeepCopy()
+	healthy.Status.Conditions = []metav1.Condition{{Type: "Healthy", Status: metav1.ConditionTrue}}
+	unhealthy := success.DeepCopy()
+	unhealthy.Status.Conditions = []metav1.Condition{{Type: "Healthy", Status: metav1.ConditionFalse, Message: "down"}}
+	notifyTransitions(recorder, filter, time.Minute, healthy, unhealthy, nil)
+	evt = requireEvent(t, recorder)
+	assert.True(t, strings.HasPrefix(evt, corev1.EventTypeWarning+" AppUnhealthy"), evt)
+	notifyTransitions(recorder, filter, time.Minute, healthy, unhealthy, nil)
+	requireNoEvent(t, recorder)
+}
+
+func TestNotifyTransitionsNotifies(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	filter := newTestTransitionFilter(&now)
+	recorder := record.NewFakeRecorder(10)
+	warning := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusWarning},
+		},
+	}
+	failing := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusError},
+		},
+	}
+	var notified []string
+
+	notify := func(eventType, reason, message string) {
+		notified = append(notified, eventType+" "+reason+" "+message)
+	}
+	notifyTransitions(recorder, filter, time.Minute, warning, failing, notify)
+	notifyTransitions(recorder, filter, time.Minute, warning, failing, notify)
+
+	assert.Equal(t, []string{requireEvent(t, recorder)}, notified)
+	requireNoEvent(t, recorder)
+}
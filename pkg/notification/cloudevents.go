@@ -0,0 +1,226 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of the CloudEvents specification the events comply with.
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsSource is the source of the events, ie, the operator.
+	CloudEventsSource = "camel-dashboard-operator"
+
+	// AppCreatedType is the type of the event sent when a Camel application is monitored for the first time.
+	AppCreatedType = "org.apache.camel.dashboard.app.created"
+	// AppDeletedType is the type of the event sent when a Camel application is deleted.
+	AppDeletedType = "org.apache.camel.dashboard.app.deleted"
+	// AppPhaseChangedType is the type of the event sent when the phase of a Camel application changes.
+	AppPhaseChangedType = "org.apache.camel.dashboard.app.phase.changed"
+	// AppSLIChangedType is the type of the event sent when the exchange SLI status of a Camel application changes.
+	AppSLIChangedType = "org.apache.camel.dashboard.app.sli.changed"
+	// AppHealthChangedType is the type of the event sent when the Healthy condition of a Camel application flips.
+	AppHealthChangedType = "org.apache.camel.dashboard.app.health.changed"
+)
+
+// CloudEvent is a CloudEvent about a Camel application, as sent in the structured content mode. In the binary content
+// mode, the attributes are sent as ce- prefixed headers, and the data as the body.
+type CloudEvent struct {
+	// SpecVersion is the version of the CloudEvents specification, 1.0
+	SpecVersion string `json:"specversion"`
+	// ID identifies the event
+	ID string `json:"id"`
+	// Source is the producer of the event, camel-dashboard-operator
+	Source string `json:"source"`
+	// Type is the type of the event, ie, org.apache.camel.dashboard.app.phase.changed
+	Type string `json:"type"`
+	// Subject is the Camel application, as namespace/name
+	Subject string `json:"subject"`
+	// Time is the time the event occurred
+	Time time.Time `json:"time"`
+	// DataContentType is the content type of the data, application/json
+	DataContentType string `json:"datacontenttype"`
+	// Data is the state of the Camel application
+	Data AppEventData `json:"data"`
+}
+
+// AppEventData is the state of a Camel application, and its previous state when it changed.
+type AppEventData struct {
+	// Namespace is the namespace of the application
+	Namespace string `json:"namespace"`
+	// Name is the name of the application
+	Name string `json:"name"`
+	// Phase is the phase of the application
+	Phase string `json:"phase,omitempty"`
+	// PreviousPhase is the phase of the application before a phase change
+	PreviousPhase string `json:"previousPhase,omitempty"`
+	// SLIStatus is the exchange SLI status of the application (Success, Warning or Error)
+	SLIStatus string `json:"sliStatus,omitempty"`
+	// PreviousSLIStatus is the exchange SLI status of the application before an SLI change
+	PreviousSLIStatus string `json:"previousSliStatus,omitempty"`
+	// SuccessPercentage is the exchange success percentage over the last sampling interval
+	SuccessPercentage string `json:"successPercentage,omitempty"`
+	// Healthy is the status of the Healthy condition of the application (True, False or Unknown)
+	Healthy string `json:"healthy,omitempty"`
+	// PreviousHealthy is the status of the Healthy condition of the application before a health change
+	PreviousHealthy string `json:"previousHealthy,omitempty"`
+	// Replicas is the number of replicas of the application
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// EmitAppCloudEvents sends the CloudEvents of the changes between the old and the new state of a Camel application
+// to the configured sink, if any. The events are sent asynchronously, not to delay the reconciliation.
+func EmitAppCloudEvents(ctx context.Context, old, newResource *v1alpha1.CamelApp) {
+	sink := platform.GetCloudEventsSink()
+	if sink == "" || old == nil || newResource == nil {
+		return
+	}
+	for _, event := range appCloudEvents(old, newResource, time.Now()) {
+		emit(sink, platform.GetCloudEventsMode(), event)
+	}
+}
+
+// EmitAppDeletedCloudEvent sends the CloudEvent of a Camel application deletion to the configured sink, if any. It is
+// meant to be called once, with the last known state of the application.
+func EmitAppDeletedCloudEvent(ctx context.Context, app *v1alpha1.CamelApp) {
+	sink := platform.GetCloudEventsSink()
+	if sink == "" || app == nil {
+		return
+	}
+	for _, event := range appDeletedCloudEvents(app, time.Now()) {
+		emit(sink, platform.GetCloudEventsMode(), event)
+	}
+}
+
+func emit(sink, mode string, event CloudEvent) {
+	go func() {
+		if err := defaultSender.sendCloudEvent(context.Background(), sink, mode, event); err != nil {
+			log.Errorf(err, "Could not send the CloudEvent %s of %s to %s", event.Type, event.Subject, sink)
+		}
+	}()
+}
+
+// appCloudEvents returns the CloudEvents of the changes between the old and the new state of a Camel application.
+func appCloudEvents(old, newResource *v1alpha1.CamelApp, now time.Time) []CloudEvent {
+	data := newAppEventData(newResource)
+	if old.Status.Phase == "" {
+		if newResource.Status.Phase == "" {
+			return nil
+		}
+		return []CloudEvent{newCloudEvent(AppCreatedType, data, now)}
+	}
+
+	var events []CloudEvent
+	if old.Status.Phase != newResource.Status.Phase {
+		phaseData := data
+		phaseData.PreviousPhase = string(old.Status.Phase)
+		events = append(events, newCloudEvent(AppPhaseChangedType, phaseData, now))
+	}
+	if previous := newAppEventData(old).SLIStatus; data.SLIStatus != "" && previous != data.SLIStatus {
+		sliData := data
+		sliData.PreviousSLIStatus = previous
+		events = append(events, newCloudEvent(AppSLIChangedType, sliData, now))
+	}
+	if previous := newAppEventData(old).Healthy; data.Healthy != "" && previous != data.Healthy {
+		healthData := data
+		healthData.PreviousHealthy = previous
+		events = append(events, newCloudEvent(AppHealthChangedType, healthData, now))
+	}
+
+	return events
+}
+
+// appDeletedCloudEvents returns the CloudEvent of a Camel application deletion. There is none for an application which
+// was never monitored, hence never reported as created.
+func appDeletedCloudEvents(app *v1alpha1.CamelApp, now time.Time) []CloudEvent {
+	if app.Status.Phase == "" {
+		return nil
+	}
+
+	return []CloudEvent{newCloudEvent(AppDeletedType, newAppEventData(app), now)}
+}
+
+func newAppEventData(app *v1alpha1.CamelApp) AppEventData {
+	data := AppEventData{
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Phase:     string(app.Status.Phase),
+		Replicas:  app.Status.Replicas,
+	}
+	if rate := app.Status.SuccessRate; rate != nil {
+		data.SLIStatus = string(rate.Status)
+		data.SuccessPercentage = rate.SuccessPercentage
+	}
+	if healthy := app.Status.GetCondition(v1alpha1.AppConditionHealthy); healthy != nil {
+		data.Healthy = string(healthy.Status)
+	}
+
+	return data
+}
+
+func newCloudEvent(eventType string, data AppEventData, now time.Time) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          CloudEventsSource,
+		Type:            eventType,
+		Subject:         data.Namespace + "/" + data.Name,
+		Time:            now.UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// sendCloudEvent posts the CloudEvent to the sink, in the binary or structured HTTP content mode.
+func (s *sender) sendCloudEvent(ctx context.Context, sink, mode string, event CloudEvent) error {
+	header := http.Header{}
+	var payload []byte
+	var err error
+	switch mode {
+	case platform.CloudEventsModeStructured:
+		header.Set("Content-Type", "application/cloudevents+json")
+		payload, err = json.Marshal(event)
+	case platform.CloudEventsModeBinary:
+		header.Set("Content-Type", event.DataContentType)
+		header.Set("ce-specversion", event.SpecVersion)
+		header.Set("ce-id", event.ID)
+		header.Set("ce-source", event.Source)
+		header.Set("ce-type", event.Type)
+		header.Set("ce-subject", event.Subject)
+		header.Set("ce-time", event.Time.Format(time.RFC3339Nano))
+		payload, err = json.Marshal(event.Data)
+	default:
+		err = fmt.Errorf("unsupported CloudEvents mode %s", mode)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, sink, header, payload)
+}
@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestAppCloudEvents(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	created := &v1alpha1.CamelApp{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"}}
+	running := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:       v1alpha1.CamelAppPhaseRunning,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusSuccess},
+			Conditions:  []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionTrue}},
+		},
+	}
+	failing := &v1alpha1.CamelApp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"},
+		Status: v1alpha1.CamelAppStatus{
+			Phase:       v1alpha1.CamelAppPhaseError,
+			SuccessRate: &v1alpha1.SLIExchangeSuccessRate{SuccessPercentage: "90.00", Status: v1alpha1.SLIExchangeStatusError},
+			Conditions:  []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionFalse}},
+		},
+	}
+
+	events := appCloudEvents(created, running, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, AppCreatedType, events[0].Type)
+	assert.Equal(t, "ns/my-app", events[0].Subject)
+	assert.Equal(t, CloudEventsSpecVersion, events[0].SpecVersion)
+	assert.NotEmpty(t, events[0].ID)
+	assert.Equal(t, string(v1alpha1.CamelAppPhaseRunning), events[0].Data.Phase)
+
+	events = appCloudEvents(running, running.DeepCopy(), now)
+	assert.Empty(t, events)
+
+	events = appCloudEvents(running, failing, now)
+	require.Len(t, events, 3)
+	assert.Equal(t, AppPhaseChangedType, events[0].Type)
+	assert.Equal(t, string(v1alpha1.CamelAppPhaseRunning), events[0].Data.PreviousPhase)
+	assert.Equal(t, string(v1alpha1.CamelAppPhaseError), events[0].Data.Phase)
+	assert.Equal(t, AppSLIChangedType, events[1].Type)
+	assert.Equal(t, "Success", events[1].Data.PreviousSLIStatus)
+	assert.Equal(t, "Error", events[1].Data.SLIStatus)
+	assert.Empty(t, events[1].Data.PreviousPhase)
+	assert.Equal(t, AppHealthChangedType, events[2].Type)
+	assert.Equal(t, "True", events[2].Data.PreviousHealthy)
+	assert.Equal(t, "False", events[2].Data.Healthy)
+}
+
+func TestAppDeletedCloudEvents(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	app := &v1alpha1.CamelApp{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-app"}}
+	assert.Empty(t, appDeletedCloudEvents(app, now))
+
+	app.Status.Phase = v1alpha1.CamelAppPhaseRunning
+	events := appDeletedCloudEvents(app, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, AppDeletedType, events[0].Type)
+	assert.Equal(t, "ns/my-app", events[0].Subject)
+	assert.Equal(t, string(v1alpha1.CamelAppPhaseRunning), events[0].Data.Phase)
+}
+
+func TestSendCloudEvent(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	s := &sender{client: server.Client(), attempts: 1, backoff: time.Millisecond}
+	event := newCloudEvent(AppSLIChangedType, AppEventData{Namespace: "ns", Name: "my-app", SLIStatus: "Error"},
+		time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	require.NoError(t, s.sendCloudEvent(context.Background(), server.URL, platform.CloudEventsModeBinary, event))
+	req, body := <-requests, <-bodies
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, "1.0", req.Header.Get("ce-specversion"))
+	assert.Equal(t, event.ID, req.Header.Get("ce-id"))
+	assert.Equal(t, CloudEventsSource, req.Header.Get("ce-source"))
+	assert.Equal(t, AppSLIChangedType, req.Header.Get("ce-type"))
+	assert.Equal(t, "ns/my-app", req.Header.Get("ce-subject"))
+	assert.Equal(t, "2026-01-01T10:00:00Z", req.Header.Get("ce-time"))
+	assert.JSONEq(t, `{"namespace":"ns","name":"my-app","sliStatus":"Error"}`, string(body))
+
+	require.NoError(t, s.sendCloudEvent(context.Background(), server.URL, platform.CloudEventsModeStructured, event))
+	req, body = <-requests, <-bodies
+	assert.Equal(t, "application/cloudevents+json", req.Header.Get("Content-Type"))
+	assert.Empty(t, req.Header.Get("ce-type"))
+	structured := CloudEvent{}
+	require.NoError(t, json.Unmarshal(body, &structured))
+	assert.Equal(t, event, structured)
+
+	require.Error(t, s.sendCloudEvent(context.Background(), server.URL, "batched", event))
+}
@@ -0,0 +1,215 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/client"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+const (
+	// webhookURLKey is the Secret key holding the webhook URL.
+	webhookURLKey = "url"
+	// defaultTemplate is the template of the message when the webhook does not configure any.
+	defaultTemplate = `[{{ .Type }}] {{ .Namespace }}/{{ .App }}: {{ .Message }}`
+)
+
+// Notification is an event of a Camel application, as notified to the webhooks.
+type Notification struct {
+	Namespace string    `json:"namespace"`
+	App       string    `json:"app"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Time      time.Time `json:"time"`
+}
+
+// sender posts the notifications, retrying with an exponential backoff when the webhook is unavailable.
+type sender struct {
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+}
+
+var defaultSender = &sender{
+	client:   &http.Client{Timeout: 10 * time.Second},
+	attempts: 4,
+	backoff:  time.Second,
+}
+
+// Notify posts an event of a Camel application to the webhooks routed by the configuration of its namespace. The
+// webhooks are notified asynchronously, not to delay the reconciliation.
+func Notify(ctx context.Context, c client.Client, app *v1alpha1.CamelApp, eventType, reason, message string) {
+	config := platform.GetNamespaceConfig(ctx, c, app.Namespace)
+	if config == nil || len(config.Notifications) == 0 {
+		return
+	}
+	notification := Notification{
+		Namespace: app.Namespace,
+		App:       app.Name,
+		Type:      eventType,
+		Reason:    reason,
+		Message:   message,
+		Time:      time.Now(),
+	}
+	for _, webhook := range config.Notifications {
+		if !matches(webhook, notification) {
+			continue
+		}
+		secret, err := c.CoreV1().Secrets(app.Namespace).Get(ctx, webhook.SecretName, metav1.GetOptions{})
+		if err != nil {
+			log.Errorf(err, "Could not get the webhook Secret %s/%s", app.Namespace, webhook.SecretName)
+			continue
+		}
+		url := strings.TrimSpace(string(secret.Data[webhookURLKey]))
+		if url == "" {
+			log.Infof("WARN: webhook Secret %s/%s has no %s key, skipping", app.Namespace, webhook.SecretName, webhookURLKey)
+			continue
+		}
+		payload, err := newPayload(webhook, notification)
+		if err != nil {
+			log.Errorf(err, "Could not create the payload of the webhook %s/%s", app.Namespace, webhook.SecretName)
+			continue
+		}
+		go func() {
+			if err := defaultSender.send(context.Background(), url, jsonHeader(), payload); err != nil {
+				log.Errorf(err, "Could not notify the webhook %s/%s", app.Namespace, webhook.SecretName)
+			}
+		}()
+	}
+}
+
+// matches returns true if the webhook is routed the notification.
+func matches(webhook v1alpha1.WebhookNotification, notification Notification) bool {
+	if webhook.WarningOnly && notification.Type != corev1.EventTypeWarning {
+		return false
+	}
+	return len(webhook.Reasons) == 0 || slices.Contains(webhook.Reasons, notification.Reason)
+}
+
+// newPayload returns the JSON payload posted to the webhook, in its format, with the message rendered by its template.
+func newPayload(webhook v1alpha1.WebhookNotification, notification Notification) ([]byte, error) {
+	text, err := render(webhook.Template, notification)
+	if err != nil {
+		return nil, err
+	}
+	switch webhook.Format {
+	case v1alpha1.WebhookFormatSlack:
+		return json.Marshal(map[string]string{"text": text})
+	case v1alpha1.WebhookFormatTeams:
+		color := "2EB886"
+		if notification.Type == corev1.EventTypeWarning {
+			color = "D63333"
+		}
+		return json.Marshal(map[string]string{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    fmt.Sprintf("%s %s/%s", notification.Reason, notification.Namespace, notification.App),
+			"themeColor": color,
+			"title":      fmt.Sprintf("Camel App %s/%s: %s", notification.Namespace, notification.App, notification.Reason),
+			"text":       text,
+		})
+	case v1alpha1.WebhookFormatGeneric, "":
+		generic := notification
+		generic.Message = text
+		return json.Marshal(generic)
+	default:
+		return nil, fmt.Errorf("unsupported webhook format %s", webhook.Format)
+	}
+}
+
+// render returns the message of the notification, rendered by the template, or the default one.
+func render(tmpl string, notification Notification) (string, error) {
+	if tmpl == "" {
+		tmpl = defaultTemplate
+	}
+	t, err := template.New("message").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var text strings.Builder
+	if err := t.Execute(&text, notification); err != nil {
+		return "", err
+	}
+
+	return text.String(), nil
+}
+
+// jsonHeader returns the headers of a JSON payload.
+func jsonHeader() http.Header {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	return header
+}
+
+// send posts the payload to the URL, with the given headers. The request is retried with an exponential backoff on
+// connection errors, 429 and 5xx responses.
+func (s *sender) send(ctx context.Context, url string, header http.Header, payload []byte) error {
+	backoff := s.backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		var retry bool
+		if retry, err = s.post(ctx, url, header, payload); err == nil || !retry || attempt >= s.attempts {
+			return err
+		}
+		log.Debugf("Notification attempt %d failed, retrying in %s: %s", attempt, backoff, err.Error())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post posts the payload once. It returns whether the request can be retried on error.
+func (s *sender) post(ctx context.Context, url string, header http.Header, payload []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header = header.Clone()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("%s responded with status %s", url, resp.Status)
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
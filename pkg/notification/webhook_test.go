@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func newTestNotification() Notification {
+	return Notification{
+		Namespace: "ns",
+		App:       "my-app",
+		Type:      corev1.EventTypeWarning,
+		Reason:    "SLIStatusChanged",
+		Message:   `App "my-app" exchange SLI status changed from "Warning" to "Error" (80.00% success)`,
+		Time:      time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestMatches(t *testing.T) {
+	notification := newTestNotification()
+
+	assert.True(t, matches(v1alpha1.WebhookNotification{}, notification))
+	assert.True(t, matches(v1alpha1.WebhookNotification{Reasons: []string{"SLIStatusChanged"}, WarningOnly: true}, notification))
+	assert.False(t, matches(v1alpha1.WebhookNotification{Reasons: []string{"AppUnhealthy"}}, notification))
+	notification.Type = corev1.EventTypeNormal
+	assert.False(t, matches(v1alpha1.WebhookNotification{WarningOnly: true}, notification))
+}
+
+func TestNewPayload(t *testing.T) {
+	notification := newTestNotification()
+
+	payload, err := newPayload(v1alpha1.WebhookNotification{}, notification)
+	require.NoError(t, err)
+	generic := Notification{}
+	require.NoError(t, json.Unmarshal(payload, &generic))
+	assert.Equal(t, "ns", generic.Namespace)
+	assert.Equal(t, "my-app", generic.App)
+	assert.Equal(t, "SLIStatusChanged", generic.Reason)
+	assert.Equal(t, "[Warning] ns/my-app: "+notification.Message, generic.Message)
+
+	payload, err = newPayload(v1alpha1.WebhookNotification{
+		Format:   v1alpha1.WebhookFormatSlack,
+		Template: `:rotating_light: {{ .App }} in {{ .Namespace }} ({{ .Reason }})`,
+	}, notification)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": ":rotating_light: my-app in ns (SLIStatusChanged)"}`, string(payload))
+
+	payload, err = newPayload(v1alpha1.WebhookNotification{Format: v1alpha1.WebhookFormatTeams}, notification)
+	require.NoError(t, err)
+	teams := map[string]string{}
+	require.NoError(t, json.Unmarshal(payload, &teams))
+	assert.Equal(t, "MessageCard", teams["@type"])
+	assert.Equal(t, "D63333", teams["themeColor"])
+	assert.Equal(t, "[Warning] ns/my-app: "+notification.Message, teams["text"])
+
+	_, err = newPayload(v1alpha1.WebhookNotification{Template: "{{ .Unknown }}"}, notification)
+	require.Error(t, err)
+	_, err = newPayload(v1alpha1.WebhookNotification{Format: "Email"}, notification)
+	require.Error(t, err)
+}
+
+func TestSendRetries(t *testing.T) {
+	var calls atomic.Int32
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	s := &sender{client: server.Client(), attempts: 4, backoff: time.Millisecond}
+	require.NoError(t, s.send(context.Background(), server.URL, jsonHeader(), []byte(`{"text":"hello"}`)))
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, `{"text":"hello"}`, string(body))
+}
+
+func TestSendGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	s := &sender{client: server.Client(), attempts: 3, backoff: time.Millisecond}
+	require.Error(t, s.send(context.Background(), server.URL, jsonHeader(), []byte(`{}`)))
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Client errors are not retried
+	calls.Store(0)
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	require.Error(t, s.send(context.Background(), server.URL, jsonHeader(), []byte(`{}`)))
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,183 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// OperatorConfigMapEnvVariable is the name of the ConfigMap, in the operator namespace, holding the operator configuration.
+	OperatorConfigMapEnvVariable = "OPERATOR_CONFIGMAP"
+)
+
+var (
+	operatorConfigLock sync.RWMutex
+	// operatorConfig holds the live operator configuration, loaded from the operator ConfigMap.
+	operatorConfig map[string]string
+)
+
+// operatorConfigValidators contains the settings that can be provided by the operator ConfigMap. The ConfigMap keys
+// are the same as the environment variables they override.
+var operatorConfigValidators = map[string]func(string) error{
+	CamelAppLabelSelector:           validateLabelSelector,
+	CamelAppNameLabel:               validateLabelKey,
+	CamelAppPollIntervalSeconds:     validatePositiveInt,
+	SLIExchangeErrorPercentage:      validatePercentage,
+	SLIExchangeWarningPercentage:    validatePercentage,
+	CamelAppObservabilityPort:       validatePort,
+	CamelAppJolokiaScrape:           validateBool,
+	CamelAppMetricsMappingConfigMap: validateName,
+	CamelAppResyncIntervalSeconds:   validatePositiveInt,
+	CamelAppNameConflictPolicy:      validateOneOf(ConflictPolicyFirstWins, ConflictPolicySuffix, ConflictPolicyAggregate),
+	OTelExportIntervalSeconds:       validatePositiveInt,
+	EventDedupWindowSeconds:         validatePositiveInt,
+	CamelAppPrometheusResources:     validateBool,
+	CamelAppHistorySize:             validatePositiveInt,
+}
+
+// GetOperatorConfigMap returns the name of the ConfigMap holding the operator configuration. It returns an empty string if not configured.
+func GetOperatorConfigMap() string {
+	if configMap, envSet := os.LookupEnv(OperatorConfigMapEnvVariable); envSet {
+		return strings.TrimSpace(configMap)
+	}
+	return ""
+}
+
+// SetOperatorConfig replaces the live operator configuration. The settings missing from the configuration fallback to
+// the environment variables.
+func SetOperatorConfig(config map[string]string) {
+	operatorConfigLock.Lock()
+	defer operatorConfigLock.Unlock()
+	operatorConfig = maps.Clone(config)
+}
+
+// lookupOperatorConfig returns a setting from the live operator configuration, or from the environment variable with the same name.
+func lookupOperatorConfig(key string) (string, bool) {
+	operatorConfigLock.RLock()
+	value, found := operatorConfig[key]
+	operatorConfigLock.RUnlock()
+	if found {
+		return value, true
+	}
+
+	return os.LookupEnv(key)
+}
+
+// ValidateOperatorConfig returns the valid settings of an operator configuration, and an error for each invalid or unknown setting.
+func ValidateOperatorConfig(data map[string]string) (map[string]string, []error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	config := map[string]string{}
+	var errs []error
+	for _, key := range keys {
+		value := strings.TrimSpace(data[key])
+		validate, ok := operatorConfigValidators[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown setting %s", key))
+			continue
+		}
+		if err := validate(value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid setting %s=%q: %w", key, value, err))
+			continue
+		}
+		config[key] = value
+	}
+
+	return config, errs
+}
+
+func validateLabelSelector(value string) error {
+	_, err := labels.Parse(value)
+	return err
+}
+
+func validateLabelKey(value string) error {
+	if errs := validation.IsQualifiedName(value); len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func validateOneOf(values ...string) func(string) error {
+	return func(value string) error {
+		if !slices.Contains(values, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(values, ", "))
+		}
+		return nil
+	}
+}
+
+func validatePositiveInt(value string) error {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	if v <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+func validatePercentage(value string) error {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	if v < 0 || v > 100 {
+		return fmt.Errorf("must be between 0 and 100")
+	}
+	return nil
+}
+
+func validatePort(value string) error {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	if errs := validation.IsValidPortNum(v); len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func validateBool(value string) error {
+	_, err := strconv.ParseBool(value)
+	return err
+}
+
+func validateName(value string) error {
+	if errs := validation.IsDNS1123Subdomain(value); len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
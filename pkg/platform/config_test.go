@@ -0,0 +1,71 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOperatorConfig(t *testing.T) {
+	config, errs := ValidateOperatorConfig(map[string]string{
+		CamelAppPollIntervalSeconds:  "30",
+		SLIExchangeErrorPercentage:   "150",
+		SLIExchangeWarningPercentage: "20",
+		CamelAppObservabilityPort:    "abc",
+		CamelAppJolokiaScrape:        "true",
+		CamelAppLabelSelector:        "my.domain/app,env in (prod)",
+		CamelAppNameLabel:            "my.domain/app",
+		"UNKNOWN":                    "value",
+	})
+
+	assert.Equal(t, map[string]string{
+		CamelAppPollIntervalSeconds:  "30",
+		SLIExchangeWarningPercentage: "20",
+		CamelAppJolokiaScrape:        "true",
+		CamelAppLabelSelector:        "my.domain/app,env in (prod)",
+		CamelAppNameLabel:            "my.domain/app",
+	}, config)
+	require.Len(t, errs, 3)
+	assert.Contains(t, errs[0].Error(), "invalid setting OBSERVABILITY_PORT=\"abc\"")
+	assert.Contains(t, errs[1].Error(), "invalid setting SLI_ERR_PERCENTAGE=\"150\": must be between 0 and 100")
+	assert.Equal(t, "unknown setting UNKNOWN", errs[2].Error())
+}
+
+func TestOperatorConfigOverridesEnv(t *testing.T) {
+	t.Setenv(CamelAppPollIntervalSeconds, "10")
+	t.Setenv(SLIExchangeErrorPercentage, "7")
+	defer SetOperatorConfig(nil)
+
+	assert.Equal(t, 10*time.Second, GetPollingInterval())
+
+	SetOperatorConfig(map[string]string{
+		CamelAppPollIntervalSeconds: "30",
+		CamelAppJolokiaScrape:       "true",
+	})
+	assert.Equal(t, 30*time.Second, GetPollingInterval())
+	assert.True(t, GetJolokiaScrape())
+	assert.Equal(t, 7, GetSLIExchangeErrorThreshold())
+
+	SetOperatorConfig(nil)
+	assert.Equal(t, 10*time.Second, GetPollingInterval())
+	assert.False(t, GetJolokiaScrape())
+}
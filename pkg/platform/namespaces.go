@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// MonitorNamespaceSelectorEnvVariable is the label selector of the namespaces whose applications the operator
+	// monitors. It only filters the applications: the operator still caches the resources of the whole cluster.
+	MonitorNamespaceSelectorEnvVariable = "MONITOR_NAMESPACE_SELECTOR"
+	// CamelAppImport enables the import of the Camel applications deployed on the cluster.
+	CamelAppImport = "CAMEL_APP_IMPORT"
+)
+
+var (
+	monitoredNamespacesLock sync.RWMutex
+	// monitoredNamespaces holds the namespaces matching the namespace selector, if any.
+	monitoredNamespaces = map[string]bool{}
+)
+
+// GetMonitorNamespaceSelector returns the label selector of the namespaces whose applications are monitored, if any.
+func GetMonitorNamespaceSelector() (labels.Selector, error) {
+	selector, envSet := os.LookupEnv(MonitorNamespaceSelectorEnvVariable)
+	if !envSet || strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	return labels.Parse(selector)
+}
+
+// IsNamespaceSelectorEnabled returns true if the operator only monitors the namespaces matching a label selector.
+func IsNamespaceSelectorEnabled() bool {
+	selector, envSet := os.LookupEnv(MonitorNamespaceSelectorEnvVariable)
+	return envSet && strings.TrimSpace(selector) != ""
+}
+
+// IsCamelAppImportEnabled returns true if the operator imports the Camel applications deployed on the cluster.
+func IsCamelAppImportEnabled() bool {
+	value, envSet := os.LookupEnv(CamelAppImport)
+	return envSet && value == "true"
+}
+
+// IsNamespaceMonitored returns true if the Camel applications of the namespace must be monitored. This is always
+// the case unless the operator only monitors the namespaces matching a label selector.
+func IsNamespaceMonitored(namespace string) bool {
+	if !IsNamespaceSelectorEnabled() {
+		return true
+	}
+	monitoredNamespacesLock.RLock()
+	defer monitoredNamespacesLock.RUnlock()
+
+	return monitoredNamespaces[namespace]
+}
+
+// SetNamespaceMonitored adds or removes a namespace from the monitored namespaces. It returns true if it was changed.
+func SetNamespaceMonitored(namespace string, monitored bool) bool {
+	monitoredNamespacesLock.Lock()
+	defer monitoredNamespacesLock.Unlock()
+	if monitoredNamespaces[namespace] == monitored {
+		return false
+	}
+	if monitored {
+		monitoredNamespaces[namespace] = true
+	} else {
+		delete(monitoredNamespaces, namespace)
+	}
+
+	return true
+}
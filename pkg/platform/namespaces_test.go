@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestGetMonitorNamespaceSelector(t *testing.T) {
+	t.Setenv(MonitorNamespaceSelectorEnvVariable, "")
+	selector, err := GetMonitorNamespaceSelector()
+	require.NoError(t, err)
+	assert.Nil(t, selector)
+	assert.False(t, IsNamespaceSelectorEnabled())
+
+	t.Setenv(MonitorNamespaceSelectorEnvVariable, "camel-dashboard/monitored=true")
+	selector, err = GetMonitorNamespaceSelector()
+	require.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{"camel-dashboard/monitored": "true"}))
+	assert.False(t, selector.Matches(labels.Set{"camel-dashboard/monitored": "false"}))
+	assert.True(t, IsNamespaceSelectorEnabled())
+
+	t.Setenv(MonitorNamespaceSelectorEnvVariable, "camel-dashboard/monitored in (true")
+	_, err = GetMonitorNamespaceSelector()
+	require.Error(t, err)
+}
+
+func TestIsNamespaceMonitored(t *testing.T) {
+	t.Setenv(MonitorNamespaceSelectorEnvVariable, "")
+	assert.True(t, IsNamespaceMonitored("ns1"))
+
+	t.Setenv(MonitorNamespaceSelectorEnvVariable, "camel-dashboard/monitored=true")
+	assert.False(t, IsNamespaceMonitored("ns1"))
+	assert.True(t, SetNamespaceMonitored("ns1", true))
+	assert.False(t, SetNamespaceMonitored("ns1", true))
+	assert.True(t, IsNamespaceMonitored("ns1"))
+	assert.False(t, IsNamespaceMonitored("ns2"))
+	assert.True(t, SetNamespaceMonitored("ns1", false))
+	assert.False(t, SetNamespaceMonitored("ns1", false))
+	assert.False(t, IsNamespaceMonitored("ns1"))
+}
@@ -20,18 +20,29 @@ package platform
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
 	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 )
 
 const (
 	OperatorWatchNamespaceEnvVariable = "WATCH_NAMESPACE"
 	operatorNamespaceEnvVariable      = "NAMESPACE"
 	CamelAppLabelSelector             = "LABEL_SELECTOR"
+	CamelAppNameLabel                 = "APP_NAME_LABEL"
+	CamelAppImportMode                = "IMPORT_MODE"
+	ImportModeLabel                   = "label"
+	ImportModeAnnotation              = "annotation"
+	CamelAppNameConflictPolicy        = "APP_NAME_CONFLICT_POLICY"
+	ConflictPolicyFirstWins           = "first-wins"
+	ConflictPolicySuffix              = "suffix"
+	ConflictPolicyAggregate           = "aggregate"
 
 	CamelAppPollIntervalSeconds             = "POLL_INTERVAL_SECONDS"
 	DefaultPollingIntervalSeconds           = 60
@@ -43,27 +54,68 @@ const (
 	defaultObservabilityPort            int = 9876
 	DefaultObservabilityMetrics             = "observe/metrics"
 	DefaultObservabilityHealth              = "observe/health"
+	CamelAppJolokiaScrape                   = "JOLOKIA_SCRAPE"
+	DefaultJolokiaEndpoint                  = "jolokia"
+	CamelAppMetricsMappingConfigMap         = "METRICS_MAPPING_CONFIGMAP"
+	CamelAppResyncIntervalSeconds           = "RESYNC_INTERVAL_SECONDS"
+	defaultResyncIntervalSeconds            = 300
+	OTelExportEndpoint                      = "OTEL_EXPORT_ENDPOINT"
+	OTelExportProtocol                      = "OTEL_EXPORT_PROTOCOL"
+	OTelProtocolHTTP                        = "http"
+	OTelProtocolGRPC                        = "grpc"
+	OTelExportIntervalSeconds               = "OTEL_EXPORT_INTERVAL_SECONDS"
+	defaultOTelExportIntervalSeconds        = 60
+	EventDedupWindowSeconds                 = "EVENT_DEDUP_WINDOW_SECONDS"
+	CamelAppPrometheusResources             = "PROMETHEUS_RESOURCES"
+	CamelAppHistorySize                     = "HISTORY_SIZE"
+	defaultHistorySize                      = 30
+	CloudEventsSink                         = "CLOUDEVENTS_SINK"
+	knativeSink                             = "K_SINK"
+	CloudEventsMode                         = "CLOUDEVENTS_MODE"
+	CloudEventsModeBinary                   = "binary"
+	CloudEventsModeStructured               = "structured"
+	defaultEventDedupWindowSeconds          = 300
+	RESTAPIPort                             = "API_PORT"
+	RESTAPITLSCertFile                      = "API_TLS_CERT_FILE"
+	RESTAPITLSKeyFile                       = "API_TLS_KEY_FILE"
+	RESTAPIInsecure                         = "API_INSECURE"
 
 	OperatorLockName = "camel-dashboard-lock"
 )
 
 // IsCurrentOperatorGlobal returns true if the operator is configured to watch all namespaces.
 func IsCurrentOperatorGlobal() bool {
-	if watchNamespace, envSet := os.LookupEnv(OperatorWatchNamespaceEnvVariable); !envSet || strings.TrimSpace(watchNamespace) == "" {
+	if len(GetOperatorWatchNamespaces()) == 0 {
 		log.Debug("Operator is global to all namespaces")
 		return true
 	}
 
-	log.Debug("Operator is local to namespace")
+	log.Debug("Operator is local to namespaces")
 	return false
 }
 
-// GetOperatorWatchNamespace returns the namespace the operator watches.
-func GetOperatorWatchNamespace() string {
-	if namespace, envSet := os.LookupEnv(OperatorWatchNamespaceEnvVariable); envSet {
-		return namespace
+// GetOperatorWatchNamespaces returns the namespaces the operator watches, provided as a comma separated list.
+// It returns an empty list if the operator watches all namespaces.
+func GetOperatorWatchNamespaces() []string {
+	watchNamespace, envSet := os.LookupEnv(OperatorWatchNamespaceEnvVariable)
+	if !envSet {
+		return nil
 	}
-	return ""
+
+	return ParseNamespaces(watchNamespace)
+}
+
+// ParseNamespaces returns the distinct namespaces of a comma separated list, ignoring the empty entries.
+func ParseNamespaces(namespaces string) []string {
+	var parsed []string
+	for _, namespace := range strings.Split(namespaces, ",") {
+		namespace = strings.TrimSpace(namespace)
+		if namespace != "" && !slices.Contains(parsed, namespace) {
+			parsed = append(parsed, namespace)
+		}
+	}
+
+	return parsed
 }
 
 // GetOperatorNamespace returns the namespace where the current operator is located (if set).
@@ -79,17 +131,64 @@ func GetOperatorLockName(operatorID string) string {
 	return fmt.Sprintf("%s-lock", operatorID)
 }
 
-// GetAppLabelSelector returns the label selector used to determine a Camel application.
+// GetAppLabelSelector returns the label selector expression used to determine a Camel application.
 func GetAppLabelSelector() string {
-	if labelSelector, envSet := os.LookupEnv(CamelAppLabelSelector); envSet && labelSelector != "" {
+	if labelSelector, envSet := lookupOperatorConfig(CamelAppLabelSelector); envSet && labelSelector != "" {
 		return labelSelector
 	}
 	return v1alpha1.AppLabel
 }
 
-// getOperatorEnvAsInt returns a generic operator environment variable as an it. It fallbacks to default value if the env var is missing.
+// GetAppNameLabel returns the label holding the name of a Camel application.
+func GetAppNameLabel() string {
+	if nameLabel, envSet := lookupOperatorConfig(CamelAppNameLabel); envSet && nameLabel != "" {
+		return nameLabel
+	}
+	return v1alpha1.AppLabel
+}
+
+// GetAppImportMode returns the way the Camel applications are selected for import: by label (default), or by label and
+// annotation.
+func GetAppImportMode() string {
+	if importMode, envSet := os.LookupEnv(CamelAppImportMode); envSet && importMode == ImportModeAnnotation {
+		return ImportModeAnnotation
+	}
+	return ImportModeLabel
+}
+
+// GetAppNameConflictPolicy returns how the workloads claiming the same Camel application name are imported: the first
+// one wins (default), the others are imported with a name suffixed by their kind and name, or all of them are
+// aggregated into the same Camel application.
+func GetAppNameConflictPolicy() string {
+	if policy, envSet := lookupOperatorConfig(CamelAppNameConflictPolicy); envSet &&
+		(policy == ConflictPolicySuffix || policy == ConflictPolicyAggregate) {
+		return policy
+	}
+	return ConflictPolicyFirstWins
+}
+
+// GetAppSelector returns the label selector used to determine a Camel application: the label selector expression,
+// which requires in any case the application name label.
+func GetAppSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(GetAppLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	hasNameLabel, err := labels.NewRequirement(GetAppNameLabel(), selection.Exists, []string{})
+	if err != nil {
+		return nil, err
+	}
+	if requirements, _ := selector.Requirements(); slices.ContainsFunc(requirements, hasNameLabel.Equal) {
+		return selector, nil
+	}
+
+	return selector.Add(*hasNameLabel), nil
+}
+
+// getOperatorEnvAsInt returns a generic operator setting (operator ConfigMap or environment variable) as an int. It fallbacks to default value
+// if the setting is missing.
 func getOperatorEnvAsInt(envVar, envVarDescription string, defaultValue int) int {
-	if envVarVal, envSet := os.LookupEnv(envVar); envSet && envVarVal != "" {
+	if envVarVal, envSet := lookupOperatorConfig(envVar); envSet && envVarVal != "" {
 		v, err := strconv.Atoi(envVarVal)
 		if err == nil {
 			return v
@@ -112,11 +211,132 @@ func GetPollingInterval() time.Duration {
 	return time.Duration(getPollingIntervalSeconds()) * time.Second
 }
 
+// GetResyncInterval returns the interval of the synthetic Camel applications resynchronization. It fallbacks to default value.
+func GetResyncInterval() time.Duration {
+	return time.Duration(getOperatorEnvAsInt(CamelAppResyncIntervalSeconds, "resync interval configuration", defaultResyncIntervalSeconds)) * time.Second
+}
+
+// GetOTelExportEndpoint returns the URL of the OpenTelemetry collector the Camel applications KPIs are pushed to
+// (ie, http://otel-collector:4318). It returns an empty string if the export is not configured.
+func GetOTelExportEndpoint() string {
+	if endpoint, envSet := os.LookupEnv(OTelExportEndpoint); envSet {
+		return strings.TrimSpace(endpoint)
+	}
+	return ""
+}
+
+// GetOTelExportProtocol returns the OTLP protocol used to push the Camel applications KPIs: http (default) or grpc.
+func GetOTelExportProtocol() string {
+	if protocol, envSet := os.LookupEnv(OTelExportProtocol); envSet && protocol == OTelProtocolGRPC {
+		return OTelProtocolGRPC
+	}
+	return OTelProtocolHTTP
+}
+
+// GetOTelExportInterval returns the interval of the Camel applications KPIs push. It fallbacks to default value.
+func GetOTelExportInterval() time.Duration {
+	return time.Duration(getOperatorEnvAsInt(OTelExportIntervalSeconds, "OpenTelemetry export interval configuration", defaultOTelExportIntervalSeconds)) * time.Second
+}
+
+// GetEventDedupWindow returns the window during which a repeat of the same transition state of an App is not emitted again. It
+// fallbacks to default value.
+func GetEventDedupWindow() time.Duration {
+	return time.Duration(getOperatorEnvAsInt(EventDedupWindowSeconds, "event de-duplication window configuration", defaultEventDedupWindowSeconds)) * time.Second
+}
+
+// GetCloudEventsSink returns the URI of the sink the Camel applications CloudEvents are sent to, or the one injected by
+// a Knative SinkBinding. It returns an empty string if not configured.
+func GetCloudEventsSink() string {
+	for _, envVar := range []string{CloudEventsSink, knativeSink} {
+		if sink, envSet := os.LookupEnv(envVar); envSet && strings.TrimSpace(sink) != "" {
+			return strings.TrimSpace(sink)
+		}
+	}
+	return ""
+}
+
+// GetCloudEventsMode returns the HTTP content mode of the CloudEvents: binary (default) or structured.
+func GetCloudEventsMode() string {
+	if mode, envSet := os.LookupEnv(CloudEventsMode); envSet && mode == CloudEventsModeStructured {
+		return CloudEventsModeStructured
+	}
+	return CloudEventsModeBinary
+}
+
+// GetRESTAPIPort returns the port of the read-only REST API. It returns 0 if the API is not enabled.
+func GetRESTAPIPort() int {
+	return getOperatorEnvAsInt(RESTAPIPort, "REST API port configuration", 0)
+}
+
+// GetRESTAPITLSFiles returns the certificate and key files the REST API is served with.
+func GetRESTAPITLSFiles() (string, string) {
+	return strings.TrimSpace(os.Getenv(RESTAPITLSCertFile)), strings.TrimSpace(os.Getenv(RESTAPITLSKeyFile))
+}
+
+// GetRESTAPIInsecure returns true if the REST API is allowed to be served over plain HTTP when no TLS certificate is
+// set. It fallbacks to false.
+func GetRESTAPIInsecure() bool {
+	if envVarVal := strings.TrimSpace(os.Getenv(RESTAPIInsecure)); envVarVal != "" {
+		v, err := strconv.ParseBool(envVarVal)
+		if err == nil {
+			return v
+		} else {
+			log.Errorf(err, "could not properly parse Operator REST API insecure configuration, fallback to default value false")
+		}
+	}
+
+	return false
+}
+
+// GetHistorySize returns the number of KPIs samples kept in the status of the applications. It fallbacks to default value.
+func GetHistorySize() int {
+	return getOperatorEnvAsInt(CamelAppHistorySize, "history size configuration", defaultHistorySize)
+}
+
 // GetObservabilityPort returns the observability port set for the operator. It fallbacks to default value.
 func GetObservabilityPort() int {
 	return getOperatorEnvAsInt(CamelAppObservabilityPort, "observability port configuration", defaultObservabilityPort)
 }
 
+// GetJolokiaScrape returns true if the operator is configured to inspect the applications via Jolokia
+// when the metrics are not available. It fallbacks to false.
+func GetJolokiaScrape() bool {
+	if envVarVal, envSet := lookupOperatorConfig(CamelAppJolokiaScrape); envSet && envVarVal != "" {
+		v, err := strconv.ParseBool(envVarVal)
+		if err == nil {
+			return v
+		} else {
+			log.Errorf(err, "could not properly parse Operator Jolokia scrape configuration, fallback to default value false")
+		}
+	}
+
+	return false
+}
+
+// GetPrometheusResources returns true if the operator is configured to generate the PodMonitor and PrometheusRule of
+// the applications. It fallbacks to false.
+func GetPrometheusResources() bool {
+	if envVarVal, envSet := lookupOperatorConfig(CamelAppPrometheusResources); envSet && envVarVal != "" {
+		v, err := strconv.ParseBool(envVarVal)
+		if err == nil {
+			return v
+		} else {
+			log.Errorf(err, "could not properly parse Operator Prometheus resources configuration, fallback to default value false")
+		}
+	}
+
+	return false
+}
+
+// GetMetricsMappingConfigMap returns the name of the ConfigMap, in the operator namespace, holding the metric-name mapping
+// used to scrape the applications. It returns an empty string if not configured.
+func GetMetricsMappingConfigMap() string {
+	if configMap, envSet := lookupOperatorConfig(CamelAppMetricsMappingConfigMap); envSet {
+		return strings.TrimSpace(configMap)
+	}
+	return ""
+}
+
 // GetSLIExchangeErrorThreshold returns the SLI Exchange error threshold configuration. It fallbacks to default value.
 func GetSLIExchangeErrorThreshold() int {
 	return getOperatorEnvAsInt(SLIExchangeErrorPercentage, "SLI exchange error threshold", defaultSLIExchangeErrorPercentage)
@@ -0,0 +1,130 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestGetOperatorWatchNamespaces(t *testing.T) {
+	t.Setenv(OperatorWatchNamespaceEnvVariable, "")
+	assert.Empty(t, GetOperatorWatchNamespaces())
+	assert.True(t, IsCurrentOperatorGlobal())
+
+	t.Setenv(OperatorWatchNamespaceEnvVariable, "ns1")
+	assert.Equal(t, []string{"ns1"}, GetOperatorWatchNamespaces())
+	assert.False(t, IsCurrentOperatorGlobal())
+
+	t.Setenv(OperatorWatchNamespaceEnvVariable, " ns1, ns2,,ns1 ")
+	assert.Equal(t, []string{"ns1", "ns2"}, GetOperatorWatchNamespaces())
+	assert.False(t, IsCurrentOperatorGlobal())
+}
+
+func TestGetAppSelector(t *testing.T) {
+	t.Setenv(CamelAppLabelSelector, "")
+	t.Setenv(CamelAppNameLabel, "")
+	selector, err := GetAppSelector()
+	assert.NoError(t, err)
+	assert.Equal(t, "camel.apache.org/app", selector.String())
+	assert.Equal(t, "camel.apache.org/app", GetAppNameLabel())
+
+	t.Setenv(CamelAppLabelSelector, "team in (a,b),env!=dev")
+	t.Setenv(CamelAppNameLabel, "app.kubernetes.io/name")
+	selector, err = GetAppSelector()
+	assert.NoError(t, err)
+	assert.True(t, selector.Matches(labels.Set{"team": "a", "env": "prod", "app.kubernetes.io/name": "my-app"}))
+	assert.False(t, selector.Matches(labels.Set{"team": "a", "env": "dev", "app.kubernetes.io/name": "my-app"}))
+	assert.False(t, selector.Matches(labels.Set{"team": "a", "env": "prod"}))
+	assert.Equal(t, "app.kubernetes.io/name", GetAppNameLabel())
+
+	t.Setenv(CamelAppLabelSelector, "team in (a")
+	_, err = GetAppSelector()
+	assert.Error(t, err)
+}
+
+func TestGetAppImportMode(t *testing.T) {
+	t.Setenv(CamelAppImportMode, "")
+	assert.Equal(t, ImportModeLabel, GetAppImportMode())
+	t.Setenv(CamelAppImportMode, "annotation")
+	assert.Equal(t, ImportModeAnnotation, GetAppImportMode())
+	t.Setenv(CamelAppImportMode, "unknown")
+	assert.Equal(t, ImportModeLabel, GetAppImportMode())
+}
+
+func TestGetOTelExportSettings(t *testing.T) {
+	t.Setenv(OTelExportEndpoint, "")
+	assert.Equal(t, "", GetOTelExportEndpoint())
+	t.Setenv(OTelExportEndpoint, " http://otel-collector:4318 ")
+	assert.Equal(t, "http://otel-collector:4318", GetOTelExportEndpoint())
+	t.Setenv(OTelExportProtocol, "")
+	assert.Equal(t, OTelProtocolHTTP, GetOTelExportProtocol())
+	t.Setenv(OTelExportProtocol, "grpc")
+	assert.Equal(t, OTelProtocolGRPC, GetOTelExportProtocol())
+	t.Setenv(OTelExportIntervalSeconds, "")
+	assert.Equal(t, time.Minute, GetOTelExportInterval())
+	t.Setenv(OTelExportIntervalSeconds, "15")
+	assert.Equal(t, 15*time.Second, GetOTelExportInterval())
+}
+
+func TestGetCloudEventsSettings(t *testing.T) {
+	t.Setenv(CloudEventsSink, "")
+	t.Setenv(knativeSink, "")
+	assert.Equal(t, "", GetCloudEventsSink())
+	t.Setenv(knativeSink, "http://broker-ingress.knative-eventing.svc/ns/default")
+	assert.Equal(t, "http://broker-ingress.knative-eventing.svc/ns/default", GetCloudEventsSink())
+	t.Setenv(CloudEventsSink, "http://event-display.ns.svc")
+	assert.Equal(t, "http://event-display.ns.svc", GetCloudEventsSink())
+	t.Setenv(CloudEventsMode, "")
+	assert.Equal(t, CloudEventsModeBinary, GetCloudEventsMode())
+	t.Setenv(CloudEventsMode, "structured")
+	assert.Equal(t, CloudEventsModeStructured, GetCloudEventsMode())
+}
+
+func TestGetAppNameConflictPolicy(t *testing.T) {
+	t.Setenv(CamelAppNameConflictPolicy, "")
+	assert.Equal(t, ConflictPolicyFirstWins, GetAppNameConflictPolicy())
+	t.Setenv(CamelAppNameConflictPolicy, "suffix")
+	assert.Equal(t, ConflictPolicySuffix, GetAppNameConflictPolicy())
+	t.Setenv(CamelAppNameConflictPolicy, "aggregate")
+	assert.Equal(t, ConflictPolicyAggregate, GetAppNameConflictPolicy())
+
+	_, errs := ValidateOperatorConfig(map[string]string{CamelAppNameConflictPolicy: "last-wins"})
+	assert.Len(t, errs, 1)
+}
+
+func TestGetRESTAPISettings(t *testing.T) {
+	t.Setenv(RESTAPIPort, "")
+	assert.Equal(t, 0, GetRESTAPIPort())
+	t.Setenv(RESTAPIPort, "8443")
+	assert.Equal(t, 8443, GetRESTAPIPort())
+	t.Setenv(RESTAPITLSCertFile, "/tls/tls.crt")
+	t.Setenv(RESTAPITLSKeyFile, " /tls/tls.key")
+	certFile, keyFile := GetRESTAPITLSFiles()
+	assert.Equal(t, "/tls/tls.crt", certFile)
+	assert.Equal(t, "/tls/tls.key", keyFile)
+	t.Setenv(RESTAPIInsecure, "")
+	assert.False(t, GetRESTAPIInsecure())
+	t.Setenv(RESTAPIInsecure, "wrong")
+	assert.False(t, GetRESTAPIInsecure())
+	t.Setenv(RESTAPIInsecure, "true")
+	assert.True(t, GetRESTAPIInsecure())
+}
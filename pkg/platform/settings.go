@@ -0,0 +1,156 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AppSettings holds the monitoring settings of a Camel application. Each setting is resolved with the following precedence:
+// the application annotation, then the namespace CamelDashboardConfig, then the operator default (operator ConfigMap,
+// environment variable or default value).
+type AppSettings struct {
+	// PollingInterval is the interval between two monitoring cycles
+	PollingInterval time.Duration
+	// SLIExchangeErrorPercentage is the percentage of failed exchanges above which the SLI is in error
+	SLIExchangeErrorPercentage int
+	// SLIExchangeWarningPercentage is the percentage of failed exchanges above which the SLI is in warning
+	SLIExchangeWarningPercentage int
+	// ObservabilityPort is the port exposing the observability services
+	ObservabilityPort int
+	// MetricsEndpoint is the path of the metrics endpoint
+	MetricsEndpoint string
+	// HealthEndpoint is the path of the health endpoint
+	HealthEndpoint string
+	// JolokiaScrape instructs to inspect the application via Jolokia when metrics are not available
+	JolokiaScrape bool
+	// Auth is the authentication required to scrape the observability services (namespace configuration only)
+	Auth *v1alpha1.ScrapeAuth
+	// PrometheusResources instructs to generate the PodMonitor and PrometheusRule of the application
+	PrometheusResources bool
+}
+
+// GetAppSettings returns the settings of a Camel application, given its annotations and the configuration of its namespace.
+func GetAppSettings(ctx context.Context, c ctrl.Reader, namespace string, annotations map[string]string) AppSettings {
+	return ResolveAppSettings(GetNamespaceConfig(ctx, c, namespace), annotations)
+}
+
+// GetNamespaceConfig returns the CamelDashboardConfig of a namespace, if any. When there are several of them, the first one
+// by name is used.
+func GetNamespaceConfig(ctx context.Context, c ctrl.Reader, namespace string) *v1alpha1.CamelDashboardConfigSpec {
+	configs := v1alpha1.CamelDashboardConfigList{}
+	if err := c.List(ctx, &configs, ctrl.InNamespace(namespace)); err != nil {
+		log.Debugf("Could not list CamelDashboardConfig in namespace %s: %s", namespace, err.Error())
+		return nil
+	}
+	if len(configs.Items) == 0 {
+		return nil
+	}
+	sort.Slice(configs.Items, func(i, j int) bool {
+		return configs.Items[i].Name < configs.Items[j].Name
+	})
+	if len(configs.Items) > 1 {
+		log.Infof("WARN: found %d CamelDashboardConfig in namespace %s, using %s", len(configs.Items), namespace, configs.Items[0].Name)
+	}
+
+	return &configs.Items[0].Spec
+}
+
+// ResolveAppSettings returns the settings of a Camel application, given its annotations and the configuration of its namespace.
+func ResolveAppSettings(config *v1alpha1.CamelDashboardConfigSpec, annotations map[string]string) AppSettings {
+	if config == nil {
+		config = &v1alpha1.CamelDashboardConfigSpec{}
+	}
+
+	pollingIntervalSeconds := intSetting(annotations, v1alpha1.AppPollingIntervalSecondsAnnotation, "polling interval",
+		config.PollingIntervalSeconds, getPollingIntervalSeconds())
+	settings := AppSettings{
+		PollingInterval: time.Duration(pollingIntervalSeconds) * time.Second,
+		SLIExchangeErrorPercentage: intSetting(annotations, v1alpha1.AppSLIExchangeErrorPercentageAnnotation, "SLI error percentage",
+			config.SLIExchangeErrorPercentage, GetSLIExchangeErrorThreshold()),
+		SLIExchangeWarningPercentage: intSetting(annotations, v1alpha1.AppSLIExchangeWarningPercentageAnnotation, "SLI warning percentage",
+			config.SLIExchangeWarningPercentage, GetSLIExchangeWarningThreshold()),
+		ObservabilityPort: intSetting(annotations, v1alpha1.AppObservabilityServicesPort, "observability services port",
+			config.ObservabilityPort, GetObservabilityPort()),
+		MetricsEndpoint: stringSetting(annotations, v1alpha1.AppObservabilityMetricsEndpointAnnotation,
+			config.MetricsEndpoint, DefaultObservabilityMetrics),
+		HealthEndpoint: stringSetting(annotations, v1alpha1.AppObservabilityHealthEndpointAnnotation,
+			config.HealthEndpoint, DefaultObservabilityHealth),
+		JolokiaScrape: boolSetting(annotations, v1alpha1.AppJolokiaScrapeAnnotation, "Jolokia scrape configuration",
+			config.JolokiaScrape, GetJolokiaScrape()),
+		Auth: config.Auth,
+		PrometheusResources: boolSetting(annotations, v1alpha1.AppPrometheusResourcesAnnotation, "Prometheus resources configuration",
+			config.PrometheusResources, GetPrometheusResources()),
+	}
+
+	return settings
+}
+
+// boolSetting returns the value of a bool application setting: the annotation if set and valid, else the namespace value
+// if set, else the operator value.
+func boolSetting(annotations map[string]string, annotation, description string, namespaceValue *bool, operatorValue bool) bool {
+	if value := annotations[annotation]; value != "" {
+		v, err := strconv.ParseBool(value)
+		if err == nil {
+			return v
+		}
+		log.Errorf(err, "could not properly parse application %s, fallback to namespace or operator value", description)
+	}
+	if namespaceValue != nil {
+		return *namespaceValue
+	}
+
+	return operatorValue
+}
+
+// intSetting returns the value of an int application setting: the annotation if set and valid, else the namespace value
+// if set, else the operator value.
+func intSetting(annotations map[string]string, annotation, description string, namespaceValue *int, operatorValue int) int {
+	if value := annotations[annotation]; value != "" {
+		v, err := strconv.Atoi(value)
+		if err == nil {
+			return v
+		}
+		log.Errorf(err, "could not properly parse application %s, fallback to namespace or operator value", description)
+	}
+	if namespaceValue != nil {
+		return *namespaceValue
+	}
+
+	return operatorValue
+}
+
+// stringSetting returns the value of a string application setting: the annotation if set, else the namespace value
+// if set, else the operator value.
+func stringSetting(annotations map[string]string, annotation string, namespaceValue, operatorValue string) string {
+	if value := annotations[annotation]; value != "" {
+		return value
+	}
+	if namespaceValue != "" {
+		return namespaceValue
+	}
+
+	return operatorValue
+}
@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestResolveAppSettingsDefaults(t *testing.T) {
+	settings := ResolveAppSettings(nil, nil)
+
+	assert.Equal(t, AppSettings{
+		PollingInterval:              time.Duration(DefaultPollingIntervalSeconds) * time.Second,
+		SLIExchangeErrorPercentage:   defaultSLIExchangeErrorPercentage,
+		SLIExchangeWarningPercentage: defaultSLIExchangeWarningPercentage,
+		ObservabilityPort:            defaultObservabilityPort,
+		MetricsEndpoint:              DefaultObservabilityMetrics,
+		HealthEndpoint:               DefaultObservabilityHealth,
+	}, settings)
+}
+
+func TestResolveAppSettingsPrecedence(t *testing.T) {
+	t.Setenv(SLIExchangeWarningPercentage, "20")
+	t.Setenv(CamelAppPrometheusResources, "true")
+	config := &v1alpha1.CamelDashboardConfigSpec{
+		PollingIntervalSeconds:     ptr.To(30),
+		SLIExchangeErrorPercentage: ptr.To(2),
+		ObservabilityPort:          ptr.To(8080),
+		MetricsEndpoint:            "q/metrics",
+		JolokiaScrape:              ptr.To(true),
+		Auth: &v1alpha1.ScrapeAuth{
+			Type:       v1alpha1.ScrapeAuthTypeBearer,
+			SecretName: "my-secret",
+		},
+	}
+	annotations := map[string]string{
+		v1alpha1.AppPollingIntervalSecondsAnnotation: "10",
+		v1alpha1.AppObservabilityServicesPort:        "wrong",
+		v1alpha1.AppJolokiaScrapeAnnotation:          "false",
+	}
+
+	settings := ResolveAppSettings(config, annotations)
+
+	assert.Equal(t, 10*time.Second, settings.PollingInterval)
+	assert.Equal(t, 2, settings.SLIExchangeErrorPercentage)
+	assert.Equal(t, 20, settings.SLIExchangeWarningPercentage)
+	assert.Equal(t, 8080, settings.ObservabilityPort)
+	assert.Equal(t, "q/metrics", settings.MetricsEndpoint)
+	assert.Equal(t, DefaultObservabilityHealth, settings.HealthEndpoint)
+	assert.False(t, settings.JolokiaScrape)
+	assert.Equal(t, config.Auth, settings.Auth)
+	assert.True(t, settings.PrometheusResources)
+
+	config.PrometheusResources = ptr.To(false)
+	assert.False(t, ResolveAppSettings(config, annotations).PrometheusResources)
+	annotations[v1alpha1.AppPrometheusResourcesAnnotation] = "true"
+	assert.True(t, ResolveAppSettings(config, annotations).PrometheusResources)
+}
+
+func TestGetNamespaceConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.CamelDashboardConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "zzz"},
+			Spec:       v1alpha1.CamelDashboardConfigSpec{PollingIntervalSeconds: ptr.To(90)},
+		},
+		&v1alpha1.CamelDashboardConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "aaa"},
+			Spec:       v1alpha1.CamelDashboardConfigSpec{PollingIntervalSeconds: ptr.To(30)},
+		},
+	).Build()
+
+	config := GetNamespaceConfig(context.TODO(), c, "ns")
+	require.NotNil(t, config)
+	assert.Equal(t, ptr.To(30), config.PollingIntervalSeconds)
+	assert.Nil(t, GetNamespaceConfig(context.TODO(), c, "other"))
+	assert.Equal(t, 30*time.Second, GetAppSettings(context.TODO(), c, "ns", nil).PollingInterval)
+}
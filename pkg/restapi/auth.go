@@ -0,0 +1,105 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	kubeutil "github.com/camel-tooling/camel-dashboard-operator/pkg/util/kubernetes"
+)
+
+// decisionTTL is the time an access review decision is reused for, so that a dashboard refreshing its views does
+// not trigger an access review for each request.
+const decisionTTL = 30 * time.Second
+
+// accessReviewer returns whether the user authenticated by the given bearer token can perform the verb on the
+// CamelApps of the namespace (all the namespaces when empty).
+type accessReviewer func(ctx context.Context, token, namespace, name, verb string) (bool, error)
+
+// newAccessReviewer returns an accessReviewer which reviews the access on behalf of the user: the SelfSubjectAccessReview
+// is created with the user token, so that the API server both authenticates the user and enforces its own RBAC.
+func newAccessReviewer(cfg *rest.Config) accessReviewer {
+	decisions := &decisionCache{
+		decisions: map[string]decision{},
+		now:       time.Now,
+	}
+	return func(ctx context.Context, token, namespace, name, verb string) (bool, error) {
+		sum := sha256.Sum256([]byte(token))
+		key := strings.Join([]string{hex.EncodeToString(sum[:]), namespace, name, verb}, "/")
+		if allowed, found := decisions.get(key); found {
+			return allowed, nil
+		}
+
+		userConfig := rest.AnonymousClientConfig(cfg)
+		userConfig.BearerToken = token
+		userClient, err := kubernetes.NewForConfig(userConfig)
+		if err != nil {
+			return false, err
+		}
+		allowed, err := kubeutil.CheckPermission(ctx, userClient, v1alpha1.SchemeGroupVersion.Group, "camelapps", namespace, name, verb)
+		if err != nil {
+			return false, err
+		}
+		decisions.put(key, allowed)
+
+		return allowed, nil
+	}
+}
+
+type decision struct {
+	allowed bool
+	expires time.Time
+}
+
+// decisionCache holds the access review decisions until they expire.
+type decisionCache struct {
+	lock      sync.Mutex
+	decisions map[string]decision
+	now       func() time.Time
+}
+
+func (c *decisionCache) get(key string) (bool, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	d, found := c.decisions[key]
+	if !found || !c.now().Before(d.expires) {
+		return false, false
+	}
+	return d.allowed, true
+}
+
+func (c *decisionCache) put(key string, allowed bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.now()
+	for k, d := range c.decisions {
+		if !now.Before(d.expires) {
+			delete(c.decisions, k)
+		}
+	}
+	c.decisions[key] = decision{allowed: allowed, expires: now.Add(decisionTTL)}
+}
@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/rest"
+)
+
+func TestAccessReviewer(t *testing.T) {
+	var reviews atomic.Int32
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer user-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`))
+			return
+		}
+		reviews.Add(1)
+		var review authorizationv1.SelfSubjectAccessReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Group == "camel.apache.org" && attributes.Resource == "camelapps" &&
+			attributes.Namespace == "ns1" && attributes.Verb == "list"
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	defer apiServer.Close()
+
+	review := newAccessReviewer(&rest.Config{
+		Host:          apiServer.URL,
+		BearerToken:   "operator-token",
+		ContentConfig: rest.ContentConfig{ContentType: "application/json"},
+	})
+
+	allowed, err := review(context.Background(), "user-token", "ns1", "", "list")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, err = review(context.Background(), "user-token", "ns2", "", "list")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	// The decisions are cached
+	allowed, err = review(context.Background(), "user-token", "ns1", "", "list")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, int32(2), reviews.Load())
+
+	// The operator credentials are never used on behalf of the user
+	_, err = review(context.Background(), "other-token", "ns1", "", "list")
+	assert.True(t, k8serrors.IsUnauthorized(err), err)
+}
+
+func TestDecisionCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	c := &decisionCache{
+		decisions: map[string]decision{},
+		now:       func() time.Time { return now },
+	}
+
+	_, found := c.get("key")
+	assert.False(t, found)
+	c.put("key", true)
+	allowed, found := c.get("key")
+	assert.True(t, found)
+	assert.True(t, allowed)
+
+	now = now.Add(decisionTTL)
+	_, found = c.get("key")
+	assert.False(t, found)
+	c.put("other", false)
+	assert.Len(t, c.decisions, 1)
+}
@@ -0,0 +1,337 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/controller/fleet"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+const (
+	basePath = "/api/v1alpha1"
+	// defaultLimit is the number of applications of a page when the request does not set it.
+	defaultLimit = 100
+	// maxLimit is the maximum number of applications of a page.
+	maxLimit = 500
+)
+
+// AppSummary is the list view of a Camel application.
+type AppSummary struct {
+	Namespace         string                     `json:"namespace"`
+	Name              string                     `json:"name"`
+	Phase             v1alpha1.CamelAppPhase     `json:"phase,omitempty"`
+	Healthy           bool                       `json:"healthy"`
+	Replicas          *int32                     `json:"replicas,omitempty"`
+	SLIStatus         v1alpha1.SLIExchangeStatus `json:"sliStatus,omitempty"`
+	SuccessPercentage string                     `json:"successPercentage,omitempty"`
+	RuntimeProvider   string                     `json:"runtimeProvider,omitempty"`
+	CamelVersion      string                     `json:"camelVersion,omitempty"`
+	Info              string                     `json:"info,omitempty"`
+}
+
+// AppList is a page of the Camel applications matching a request.
+type AppList struct {
+	// the applications of the page
+	Items []AppSummary `json:"items"`
+	// the token to request the next page with, empty on the last page
+	Continue string `json:"continue,omitempty"`
+	// the number of applications matching the request, across all the pages
+	Total int `json:"total"`
+}
+
+// Error is the body of an unsuccessful response.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// handler serves the REST API from the manager cache.
+type handler struct {
+	reader ctrl.Reader
+	review accessReviewer
+}
+
+func newHandler(reader ctrl.Reader, review accessReviewer) http.Handler {
+	h := &handler{
+		reader: reader,
+		review: review,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+basePath+"/apps", h.listApps)
+	mux.HandleFunc("GET "+basePath+"/namespaces/{namespace}/apps/{name}", h.getApp)
+	mux.HandleFunc("GET "+basePath+"/fleet", h.getFleet)
+	return mux
+}
+
+// listApps serves the page of the applications matching the namespace, phase, sli and runtime query parameters.
+func (h *handler) listApps(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	if !h.authorize(w, r, namespace, "", "list") {
+		return
+	}
+	limit, err := getLimit(query.Get("limit"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	after, err := decodeContinue(query.Get("continue"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid continue token")
+		return
+	}
+	apps, err := h.listMonitoredApps(r, namespace)
+	if err != nil {
+		log.Error(err, "Could not list the Camel Apps")
+		writeError(w, http.StatusInternalServerError, "could not list the Camel Apps")
+		return
+	}
+
+	phase, sli, runtime := query.Get("phase"), query.Get("sli"), query.Get("runtime")
+	var matching []AppSummary
+	for i := range apps {
+		app := newAppSummary(&apps[i])
+		if (phase == "" || strings.EqualFold(string(app.Phase), phase)) &&
+			(sli == "" || strings.EqualFold(string(app.SLIStatus), sli)) &&
+			(runtime == "" || strings.EqualFold(app.RuntimeProvider, runtime)) {
+			matching = append(matching, app)
+		}
+	}
+
+	list := AppList{
+		Items: []AppSummary{},
+		Total: len(matching),
+	}
+	start := sort.Search(len(matching), func(i int) bool {
+		return appKey(matching[i].Namespace, matching[i].Name) > after
+	})
+	end := min(start+limit, len(matching))
+	list.Items = append(list.Items, matching[start:end]...)
+	if end < len(matching) {
+		last := matching[end-1]
+		list.Continue = base64.RawURLEncoding.EncodeToString([]byte(appKey(last.Namespace, last.Name)))
+	}
+
+	writeJSON(w, r, list)
+}
+
+// getApp serves the detail of an application.
+func (h *handler) getApp(w http.ResponseWriter, r *http.Request) {
+	namespace, name := r.PathValue("namespace"), r.PathValue("name")
+	if !h.authorize(w, r, namespace, name, "get") {
+		return
+	}
+	var app v1alpha1.CamelApp
+	if err := h.reader.Get(r.Context(), types.NamespacedName{Namespace: namespace, Name: name}, &app); err != nil || !platform.IsNamespaceMonitored(namespace) {
+		if err == nil || k8serrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Camel App %s/%s not found", namespace, name))
+			return
+		}
+		log.Errorf(err, "Could not get the Camel App %s/%s", namespace, name)
+		writeError(w, http.StatusInternalServerError, "could not get the Camel App")
+		return
+	}
+	app.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	app.Kind = v1alpha1.AppKind
+	app.ManagedFields = nil
+
+	writeJSON(w, r, app)
+}
+
+// getFleet serves the summary of the applications of the namespace query parameter, or of all of them.
+func (h *handler) getFleet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	namespace := query.Get("namespace")
+	if !h.authorize(w, r, namespace, "", "list") {
+		return
+	}
+	worstApps := fleet.DefaultWorstApps
+	if value := query.Get("worstApps"); value != "" {
+		var err error
+		if worstApps, err = strconv.Atoi(value); err != nil || worstApps < 0 {
+			writeError(w, http.StatusBadRequest, "worstApps must be a positive integer")
+			return
+		}
+	}
+	apps, err := h.listMonitoredApps(r, namespace)
+	if err != nil {
+		log.Error(err, "Could not list the Camel Apps")
+		writeError(w, http.StatusInternalServerError, "could not list the Camel Apps")
+		return
+	}
+
+	writeJSON(w, r, fleet.Summarize(apps, worstApps))
+}
+
+// authorize checks the user of the request can perform the verb on the CamelApps, and writes the error response
+// if not.
+func (h *handler) authorize(w http.ResponseWriter, r *http.Request, namespace, name, verb string) bool {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || strings.TrimSpace(token) == "" {
+		writeError(w, http.StatusUnauthorized, "a bearer token is required")
+		return false
+	}
+	allowed, err := h.review(r.Context(), strings.TrimSpace(token), namespace, name, verb)
+	if err != nil {
+		if k8serrors.IsUnauthorized(err) {
+			writeError(w, http.StatusUnauthorized, "invalid bearer token")
+			return false
+		}
+		log.Error(err, "Could not review the REST API access")
+		writeError(w, http.StatusInternalServerError, "could not review the access")
+		return false
+	}
+	if !allowed {
+		scope := "all namespaces"
+		if namespace != "" {
+			scope = "namespace " + namespace
+		}
+		writeError(w, http.StatusForbidden, fmt.Sprintf("cannot %s the Camel Apps of %s", verb, scope))
+		return false
+	}
+	return true
+}
+
+// listMonitoredApps lists the applications of the monitored namespaces, sorted by namespace and name.
+func (h *handler) listMonitoredApps(r *http.Request, namespace string) ([]v1alpha1.CamelApp, error) {
+	var list v1alpha1.CamelAppList
+	var opts []ctrl.ListOption
+	if namespace != "" {
+		opts = append(opts, ctrl.InNamespace(namespace))
+	}
+	if err := h.reader.List(r.Context(), &list, opts...); err != nil {
+		return nil, err
+	}
+	apps := make([]v1alpha1.CamelApp, 0, len(list.Items))
+	for _, app := range list.Items {
+		if platform.IsNamespaceMonitored(app.Namespace) {
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return appKey(apps[i].Namespace, apps[i].Name) < appKey(apps[j].Namespace, apps[j].Name)
+	})
+	return apps, nil
+}
+
+func newAppSummary(app *v1alpha1.CamelApp) AppSummary {
+	summary := AppSummary{
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Phase:     app.Status.Phase,
+		Replicas:  app.Status.Replicas,
+		Info:      app.Status.Info,
+	}
+	if healthy := app.Status.GetCondition(v1alpha1.AppConditionHealthy); healthy != nil {
+		summary.Healthy = healthy.Status == metav1.ConditionTrue
+	}
+	if rate := app.Status.SuccessRate; rate != nil {
+		summary.SLIStatus = rate.Status
+		summary.SuccessPercentage = rate.SuccessPercentage
+	}
+	for _, pod := range app.Status.Pods {
+		if pod.Runtime != nil && (pod.Runtime.RuntimeProvider != "" || pod.Runtime.CamelVersion != "") {
+			summary.RuntimeProvider = pod.Runtime.RuntimeProvider
+			summary.CamelVersion = pod.Runtime.CamelVersion
+			break
+		}
+	}
+	return summary
+}
+
+// appKey returns the key the applications are sorted and paginated by.
+func appKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func getLimit(value string) (int, error) {
+	if value == "" {
+		return defaultLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("limit must be a strictly positive integer")
+	}
+	return min(limit, maxLimit), nil
+}
+
+func decodeContinue(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// writeJSON writes the value with an ETag computed from its content, or a 304 Not Modified response when the request
+// already holds it.
+func writeJSON(w http.ResponseWriter, r *http.Request, value interface{}) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		log.Error(err, "Could not encode the REST API response")
+		writeError(w, http.StatusInternalServerError, "could not encode the response")
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(Error{Code: code, Message: message})
+}
@@ -0,0 +1,209 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+// newTestHandler returns a handler allowing the "admin" token to access all the namespaces, and the "ns1" token to
+// access the ns1 namespace only.
+func newTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	healthy := []metav1.Condition{{Type: v1alpha1.AppConditionHealthy, Status: metav1.ConditionTrue}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1alpha1.CamelApp{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "a"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				Conditions:  healthy,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusSuccess, SuccessPercentage: "90.00"},
+				Pods:        []v1alpha1.PodInfo{{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Quarkus", CamelVersion: "4.10.0"}}},
+			},
+		},
+		&v1alpha1.CamelApp{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "b"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				Conditions:  healthy,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusError, SuccessPercentage: "90.00"},
+				Pods:        []v1alpha1.PodInfo{{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Spring-Boot", CamelVersion: "4.10.0"}}},
+			},
+		},
+		&v1alpha1.CamelApp{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "c"},
+			Status:     v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhaseError, Conditions: healthy},
+		},
+		&v1alpha1.CamelApp{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "d"},
+			Status: v1alpha1.CamelAppStatus{
+				Phase:       v1alpha1.CamelAppPhaseRunning,
+				Conditions:  healthy,
+				SuccessRate: &v1alpha1.SLIExchangeSuccessRate{Status: v1alpha1.SLIExchangeStatusWarning, SuccessPercentage: "90.00"},
+				Pods:        []v1alpha1.PodInfo{{Name: "pod", Runtime: &v1alpha1.RuntimeInfo{RuntimeProvider: "Quarkus", CamelVersion: "4.10.0"}}},
+			},
+		},
+	).Build()
+	review := func(ctx context.Context, token, namespace, name, verb string) (bool, error) {
+		switch token {
+		case "admin":
+			return true, nil
+		case "ns1":
+			return namespace == "ns1", nil
+		default:
+			return false, k8serrors.NewUnauthorized("invalid token")
+		}
+	}
+	return newHandler(c, review)
+}
+
+func doRequest(h http.Handler, token, target string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeList(t *testing.T, rec *httptest.ResponseRecorder) AppList {
+	t.Helper()
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list AppList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	return list
+}
+
+func names(list AppList) []string {
+	var names []string
+	for _, app := range list.Items {
+		names = append(names, app.Name)
+	}
+	return names
+}
+
+func TestListAppsAuthorization(t *testing.T) {
+	h := newTestHandler(t)
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "", "/api/v1alpha1/apps").Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(h, "unknown", "/api/v1alpha1/apps").Code)
+	rec := doRequest(h, "ns1", "/api/v1alpha1/apps")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "cannot list the Camel Apps of all namespaces")
+	assert.Equal(t, []string{"a", "b", "c"}, names(decodeList(t, doRequest(h, "ns1", "/api/v1alpha1/apps?namespace=ns1"))))
+}
+
+func TestListAppsFilters(t *testing.T) {
+	h := newTestHandler(t)
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, names(decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps"))))
+	assert.Equal(t, []string{"c"}, names(decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?phase=Error"))))
+	assert.Equal(t, []string{"b"}, names(decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?sli=error"))))
+	assert.Equal(t, []string{"a", "d"}, names(decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?runtime=quarkus"))))
+	assert.Equal(t, []string{"a"}, names(decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?runtime=quarkus&namespace=ns1"))))
+
+	list := decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?runtime=quarkus"))
+	assert.Equal(t, AppSummary{
+		Namespace: "ns1", Name: "a", Phase: v1alpha1.CamelAppPhaseRunning, Healthy: true, SLIStatus: v1alpha1.SLIExchangeStatusSuccess,
+		SuccessPercentage: "90.00", RuntimeProvider: "Quarkus", CamelVersion: "4.10.0",
+	}, list.Items[0])
+}
+
+func TestListAppsPagination(t *testing.T) {
+	h := newTestHandler(t)
+
+	list := decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?limit=3"))
+	assert.Equal(t, []string{"a", "b", "c"}, names(list))
+	assert.Equal(t, 4, list.Total)
+	require.NotEmpty(t, list.Continue)
+
+	list = decodeList(t, doRequest(h, "admin", "/api/v1alpha1/apps?limit=3&continue="+list.Continue))
+	assert.Equal(t, []string{"d"}, names(list))
+	assert.Empty(t, list.Continue)
+
+	assert.Equal(t, http.StatusBadRequest, doRequest(h, "admin", "/api/v1alpha1/apps?limit=0").Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(h, "admin", "/api/v1alpha1/apps?continue=%25").Code)
+}
+
+func TestETag(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := doRequest(h, "admin", "/api/v1alpha1/apps")
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	rec = doRequest(h, "admin", "/api/v1alpha1/apps", "If-None-Match", etag)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = doRequest(h, "admin", "/api/v1alpha1/apps?phase=Error", "If-None-Match", etag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+}
+
+func TestGetApp(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := doRequest(h, "ns1", "/api/v1alpha1/namespaces/ns1/apps/b")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var app v1alpha1.CamelApp
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &app))
+	assert.Equal(t, schema.GroupVersionKind{Group: "camel.apache.org", Version: "v1alpha1", Kind: "CamelApp"}, app.GroupVersionKind())
+	assert.Equal(t, v1alpha1.SLIExchangeStatusError, app.Status.SuccessRate.Status)
+
+	assert.Equal(t, http.StatusNotFound, doRequest(h, "ns1", "/api/v1alpha1/namespaces/ns1/apps/missing").Code)
+	assert.Equal(t, http.StatusForbidden, doRequest(h, "ns1", "/api/v1alpha1/namespaces/ns2/apps/d").Code)
+}
+
+func TestGetFleet(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := doRequest(h, "admin", "/api/v1alpha1/fleet?worstApps=2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status v1alpha1.CamelFleetStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, 4, status.Apps)
+	assert.Equal(t, map[string]int{"Running": 3, "Error": 1}, status.Phases)
+	require.Len(t, status.WorstApps, 2)
+	assert.Equal(t, "c", status.WorstApps[0].Name)
+	assert.Equal(t, "b", status.WorstApps[1].Name)
+
+	assert.Equal(t, http.StatusForbidden, doRequest(h, "ns1", "/api/v1alpha1/fleet").Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(h, "admin", "/api/v1alpha1/fleet?worstApps=-1").Code)
+}
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+// Add adds the read-only REST API server to the manager, if enabled. The API is served from the manager cache, and
+// the access of each user is reviewed by the API server.
+func Add(mgr manager.Manager) error {
+	port := platform.GetRESTAPIPort()
+	if port <= 0 {
+		log.Info("REST API not configured, skipping")
+		return nil
+	}
+	certFile, keyFile, err := getTLSFiles()
+	if err != nil {
+		return err
+	}
+
+	return mgr.Add(&server{
+		addr:     ":" + strconv.Itoa(port),
+		certFile: certFile,
+		keyFile:  keyFile,
+		handler:  newHandler(mgr.GetCache(), newAccessReviewer(mgr.GetConfig())),
+	})
+}
+
+// getTLSFiles returns the certificate and key files the REST API is served with. As the API forwards the bearer tokens
+// of the users, it is only served over plain HTTP when explicitly allowed.
+func getTLSFiles() (string, string, error) {
+	certFile, keyFile := platform.GetRESTAPITLSFiles()
+	switch {
+	case certFile != "" && keyFile != "":
+		return certFile, keyFile, nil
+	case certFile != "" || keyFile != "":
+		return "", "", fmt.Errorf("both %s and %s must be set to serve the REST API over HTTPS",
+			platform.RESTAPITLSCertFile, platform.RESTAPITLSKeyFile)
+	case platform.GetRESTAPIInsecure():
+		log.Info("REST API TLS not configured, the user bearer tokens are sent over plain HTTP")
+		return "", "", nil
+	default:
+		return "", "", fmt.Errorf("%s and %s must be set to serve the REST API over HTTPS, or %s=true to serve it over plain HTTP",
+			platform.RESTAPITLSCertFile, platform.RESTAPITLSKeyFile, platform.RESTAPIInsecure)
+	}
+}
+
+// server is the manager runnable serving the REST API.
+type server struct {
+	addr     string
+	certFile string
+	keyFile  string
+	handler  http.Handler
+}
+
+// NeedLeaderElection lets every operator replica serve the REST API from its own cache.
+func (s *server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *server) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Error(err, "Some error happened while shutting down the REST API server")
+		}
+	}()
+
+	var err error
+	if s.certFile != "" {
+		log.Infof("Serving the REST API over HTTPS on %s", s.addr)
+		err = srv.ListenAndServeTLS(s.certFile, s.keyFile)
+	} else {
+		log.Infof("Serving the REST API over HTTP on %s", s.addr)
+		err = srv.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+func TestGetTLSFiles(t *testing.T) {
+	t.Setenv(platform.RESTAPITLSCertFile, "")
+	t.Setenv(platform.RESTAPITLSKeyFile, "")
+	t.Setenv(platform.RESTAPIInsecure, "")
+	_, _, err := getTLSFiles()
+	require.Error(t, err)
+
+	// Plain HTTP must be explicitly allowed
+	t.Setenv(platform.RESTAPIInsecure, "true")
+	certFile, keyFile, err := getTLSFiles()
+	require.NoError(t, err)
+	assert.Empty(t, certFile)
+	assert.Empty(t, keyFile)
+
+	// An incomplete TLS configuration does not fall back to plain HTTP
+	t.Setenv(platform.RESTAPITLSCertFile, "/tls/tls.crt")
+	_, _, err = getTLSFiles()
+	require.Error(t, err)
+
+	t.Setenv(platform.RESTAPITLSKeyFile, "/tls/tls.key")
+	certFile, keyFile, err = getTLSFiles()
+	require.NoError(t, err)
+	assert.Equal(t, "/tls/tls.crt", certFile)
+	assert.Equal(t, "/tls/tls.key", keyFile)
+}
@@ -23,13 +23,18 @@ import (
 
 // Indicate if the pod exposes a port named jolokia.
 func JolokiaEnabled(pod corev1.Pod) bool {
+	return JolokiaPort(pod) != 0
+}
+
+// JolokiaPort returns the container port named jolokia exposed by the pod, or 0 if there is none.
+func JolokiaPort(pod corev1.Pod) int {
 	for _, container := range pod.Spec.Containers {
 		for _, port := range container.Ports {
 			if port.Name == "jolokia" {
-				return true
+				return int(port.ContainerPort)
 			}
 		}
 
 	}
-	return false
+	return 0
 }
@@ -101,3 +101,21 @@ func TestJolokiaEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestJolokiaPort(t *testing.T) {
+	pod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "app",
+					Ports: []corev1.ContainerPort{
+						{Name: "http", ContainerPort: 8080},
+						{Name: "jolokia", ContainerPort: 8778},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, 8778, JolokiaPort(pod))
+	require.Equal(t, 0, JolokiaPort(corev1.Pod{}))
+}
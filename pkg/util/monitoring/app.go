@@ -0,0 +1,198 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+const (
+	appLabel          = "app"
+	runtimeLabel      = "runtime"
+	camelVersionLabel = "camel_version"
+	phaseLabel        = "phase"
+	statusLabel       = "status"
+)
+
+var (
+	appLabels = []string{namespaceLabel, appLabel, runtimeLabel, camelVersionLabel}
+
+	appPhaseDesc = prometheus.NewDesc("camel_dashboard_app_phase",
+		"Camel App phase, as 1 for the current phase", append(appLabels, phaseLabel), nil)
+	appReplicasDesc = prometheus.NewDesc("camel_dashboard_app_replicas",
+		"Camel App replicas", appLabels, nil)
+	appReadyPodsDesc = prometheus.NewDesc("camel_dashboard_app_ready_pods",
+		"Camel App ready Pods", appLabels, nil)
+	appSuccessPercentageDesc = prometheus.NewDesc("camel_dashboard_app_sli_exchange_success_percentage",
+		"Camel App exchange success percentage over the last sampling interval", appLabels, nil)
+	appSLIStatusDesc = prometheus.NewDesc("camel_dashboard_app_sli_exchange_status",
+		"Camel App exchange SLI status, as 1 for the current status", append(appLabels, statusLabel), nil)
+	appExchangesTotalDesc = prometheus.NewDesc("camel_dashboard_app_exchanges_total",
+		"Camel App total number of exchanges across all the Pods", appLabels, nil)
+	appExchangesFailedDesc = prometheus.NewDesc("camel_dashboard_app_exchanges_failed_total",
+		"Camel App total number of failed exchanges across all the Pods", appLabels, nil)
+	appExchangesInflightDesc = prometheus.NewDesc("camel_dashboard_app_exchanges_inflight",
+		"Camel App number of inflight exchanges across all the Pods", appLabels, nil)
+	appLastExchangeAgeDesc = prometheus.NewDesc("camel_dashboard_app_last_exchange_age_seconds",
+		"Camel App time elapsed since the last exchange", appLabels, nil)
+)
+
+// appSnapshot holds the KPIs of a Camel App, as of its last reconciliation.
+type appSnapshot struct {
+	labels            []string
+	phase             string
+	replicas          *int32
+	readyPods         int
+	successPercentage *float64
+	sliStatus         string
+	exchangesTotal    int
+	exchangesFailed   int
+	exchangesInflight int
+	// exchangesStart is the time the exchanges totals are accumulated since, ie, the last Pod start
+	exchangesStart *time.Time
+	lastExchange   *time.Time
+}
+
+// appCollector exports the KPIs of the Camel Apps. The series of an App are replaced as a whole on each update, so
+// that no series is left behind when a label value changes or the App is deleted.
+type appCollector struct {
+	lock sync.RWMutex
+	apps map[string]appSnapshot
+	now  func() time.Time
+}
+
+var _ prometheus.Collector = &appCollector{}
+
+var appMetrics = &appCollector{
+	apps: map[string]appSnapshot{},
+	now:  time.Now,
+}
+
+// UpdateAppMetrics exports the KPIs of a Camel App, replacing the previous ones.
+func UpdateAppMetrics(app *v1alpha1.CamelApp) {
+	appMetrics.update(app)
+}
+
+// DeleteAppMetrics removes the KPIs of a Camel App.
+func DeleteAppMetrics(namespace, name string) {
+	appMetrics.delete(namespace, name)
+}
+
+func (c *appCollector) update(app *v1alpha1.CamelApp) {
+	snapshot := newAppSnapshot(app)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.apps[app.Namespace+"/"+app.Name] = snapshot
+}
+
+func (c *appCollector) delete(namespace, name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.apps, namespace+"/"+name)
+}
+
+func newAppSnapshot(app *v1alpha1.CamelApp) appSnapshot {
+	runtime, camelVersion := "", ""
+	snapshot := appSnapshot{
+		phase:    string(app.Status.Phase),
+		replicas: app.Status.Replicas,
+	}
+	for _, pod := range app.Status.Pods {
+		if pod.Ready {
+			snapshot.readyPods++
+		}
+		if pod.Runtime == nil {
+			continue
+		}
+		if runtime == "" && camelVersion == "" {
+			runtime = pod.Runtime.RuntimeProvider
+			camelVersion = pod.Runtime.CamelVersion
+		}
+		if exchange := pod.Runtime.Exchange; exchange != nil {
+			snapshot.exchangesTotal += exchange.Total
+			snapshot.exchangesFailed += exchange.Failed
+			snapshot.exchangesInflight += exchange.Pending
+			if pod.UptimeTimestamp != nil && (snapshot.exchangesStart == nil || pod.UptimeTimestamp.After(*snapshot.exchangesStart)) {
+				snapshot.exchangesStart = &pod.UptimeTimestamp.Time
+			}
+			if exchange.LastTimestamp != nil && (snapshot.lastExchange == nil || exchange.LastTimestamp.After(*snapshot.lastExchange)) {
+				snapshot.lastExchange = &exchange.LastTimestamp.Time
+			}
+		}
+	}
+	if rate := app.Status.SuccessRate; rate != nil {
+		if percentage, err := strconv.ParseFloat(rate.SuccessPercentage, 64); err == nil {
+			snapshot.successPercentage = &percentage
+		}
+		snapshot.sliStatus = string(rate.Status)
+	}
+	snapshot.labels = []string{app.Namespace, app.Name, runtime, camelVersion}
+
+	return snapshot
+}
+
+func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- appPhaseDesc
+	ch <- appReplicasDesc
+	ch <- appReadyPodsDesc
+	ch <- appSuccessPercentageDesc
+	ch <- appSLIStatusDesc
+	ch <- appExchangesTotalDesc
+	ch <- appExchangesFailedDesc
+	ch <- appExchangesInflightDesc
+	ch <- appLastExchangeAgeDesc
+}
+
+func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, app := range c.apps {
+		if app.phase != "" {
+			ch <- prometheus.MustNewConstMetric(appPhaseDesc, prometheus.GaugeValue, 1, append(app.labels, app.phase)...)
+		}
+		if app.replicas != nil && *app.replicas >= 0 {
+			ch <- prometheus.MustNewConstMetric(appReplicasDesc, prometheus.GaugeValue, float64(*app.replicas), app.labels...)
+		}
+		ch <- prometheus.MustNewConstMetric(appReadyPodsDesc, prometheus.GaugeValue, float64(app.readyPods), app.labels...)
+		if app.successPercentage != nil {
+			ch <- prometheus.MustNewConstMetric(appSuccessPercentageDesc, prometheus.GaugeValue, *app.successPercentage, app.labels...)
+		}
+		if app.sliStatus != "" {
+			ch <- prometheus.MustNewConstMetric(appSLIStatusDesc, prometheus.GaugeValue, 1, append(app.labels, app.sliStatus)...)
+		}
+		ch <- prometheus.MustNewConstMetric(appExchangesTotalDesc, prometheus.CounterValue, float64(app.exchangesTotal), app.labels...)
+		ch <- prometheus.MustNewConstMetric(appExchangesFailedDesc, prometheus.CounterValue, float64(app.exchangesFailed), app.labels...)
+		ch <- prometheus.MustNewConstMetric(appExchangesInflightDesc, prometheus.GaugeValue, float64(app.exchangesInflight), app.labels...)
+		if app.lastExchange != nil {
+			ch <- prometheus.MustNewConstMetric(appLastExchangeAgeDesc, prometheus.GaugeValue,
+				c.now().Sub(*app.lastExchange).Seconds(), app.labels...)
+		}
+	}
+}
+
+func init() {
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(appMetrics)
+}
@@ -0,0 +1,106 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+)
+
+func TestAppCollector(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	collector := &appCollector{
+		apps: map[string]appSnapshot{},
+		now:  func() time.Time { return now },
+	}
+	app := v1alpha1.NewApp("ns", "my-app")
+	app.Status = v1alpha1.CamelAppStatus{
+		Phase:    v1alpha1.CamelAppPhaseRunning,
+		Replicas: ptr.To(int32(2)),
+		Pods: []v1alpha1.PodInfo{
+			{
+				Ready: true,
+				Runtime: &v1alpha1.RuntimeInfo{
+					RuntimeProvider: "Quarkus",
+					CamelVersion:    "4.10.0",
+					Exchange: &v1alpha1.ExchangeInfo{
+						Total:         10,
+						Failed:        1,
+						Pending:       2,
+						LastTimestamp: &metav1.Time{Time: now.Add(-30 * time.Second)},
+					},
+				},
+			},
+			{
+				Runtime: &v1alpha1.RuntimeInfo{
+					RuntimeProvider: "Quarkus",
+					CamelVersion:    "4.10.0",
+					Exchange:        &v1alpha1.ExchangeInfo{Total: 5},
+				},
+			},
+		},
+		SuccessRate: &v1alpha1.SLIExchangeSuccessRate{
+			SuccessPercentage: "90.00",
+			Status:            v1alpha1.SLIExchangeStatusWarning,
+		},
+	}
+
+	collector.update(&app)
+	expected := `
+# HELP camel_dashboard_app_exchanges_total Camel App total number of exchanges across all the Pods
+# TYPE camel_dashboard_app_exchanges_total counter
+camel_dashboard_app_exchanges_total{app="my-app",camel_version="4.10.0",namespace="ns",runtime="Quarkus"} 15
+# HELP camel_dashboard_app_last_exchange_age_seconds Camel App time elapsed since the last exchange
+# TYPE camel_dashboard_app_last_exchange_age_seconds gauge
+camel_dashboard_app_last_exchange_age_seconds{app="my-app",camel_version="4.10.0",namespace="ns",runtime="Quarkus"} 30
+# HELP camel_dashboard_app_phase Camel App phase, as 1 for the current phase
+# TYPE camel_dashboard_app_phase gauge
+camel_dashboard_app_phase{app="my-app",camel_version="4.10.0",namespace="ns",phase="Running",runtime="Quarkus"} 1
+# HELP camel_dashboard_app_ready_pods Camel App ready Pods
+# TYPE camel_dashboard_app_ready_pods gauge
+camel_dashboard_app_ready_pods{app="my-app",camel_version="4.10.0",namespace="ns",runtime="Quarkus"} 1
+# HELP camel_dashboard_app_sli_exchange_success_percentage Camel App exchange success percentage over the last sampling interval
+# TYPE camel_dashboard_app_sli_exchange_success_percentage gauge
+camel_dashboard_app_sli_exchange_success_percentage{app="my-app",camel_version="4.10.0",namespace="ns",runtime="Quarkus"} 90
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"camel_dashboard_app_exchanges_total",
+		"camel_dashboard_app_last_exchange_age_seconds",
+		"camel_dashboard_app_phase",
+		"camel_dashboard_app_ready_pods",
+		"camel_dashboard_app_sli_exchange_success_percentage",
+	))
+	assert.Equal(t, 9, testutil.CollectAndCount(collector))
+
+	app.Status = v1alpha1.CamelAppStatus{Phase: v1alpha1.CamelAppPhasePaused}
+	collector.update(&app)
+	// phase, ready pods and exchanges, with no runtime labels any longer
+	assert.Equal(t, 5, testutil.CollectAndCount(collector))
+
+	collector.delete("ns", "my-app")
+	assert.Equal(t, 0, testutil.CollectAndCount(collector))
+}
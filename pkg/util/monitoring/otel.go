@@ -0,0 +1,253 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/util/log"
+)
+
+const (
+	otelScopeName       = "github.com/camel-tooling/camel-dashboard-operator"
+	otelHTTPMetricsPath = "/v1/metrics"
+
+	camelRuntimeAttribute = attribute.Key("camel.runtime.provider")
+	camelVersionAttribute = attribute.Key("camel.version")
+	phaseAttribute        = attribute.Key("camel.app.phase")
+	statusAttribute       = attribute.Key("camel.app.sli.exchange.status")
+)
+
+// NewOTelExporter returns an OTLP exporter pushing to the collector endpoint URL, via the http or grpc protocol.
+// The connection is insecure unless the endpoint scheme is https.
+func NewOTelExporter(ctx context.Context, endpoint, protocol string) (sdkmetric.Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid OpenTelemetry endpoint %q: expected a URL like http://otel-collector:4318", endpoint)
+	}
+	insecure := u.Scheme != "https"
+	switch protocol {
+	case platform.OTelProtocolGRPC:
+		options := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(u.Host)}
+		if insecure {
+			options = append(options, otlpmetricgrpc.WithInsecure())
+		}
+		return otlpmetricgrpc.New(ctx, options...)
+	case platform.OTelProtocolHTTP:
+		path := u.Path
+		if path == "" || path == "/" {
+			path = otelHTTPMetricsPath
+		}
+		options := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(u.Host), otlpmetrichttp.WithURLPath(path)}
+		if insecure {
+			options = append(options, otlpmetrichttp.WithInsecure())
+		}
+		return otlpmetrichttp.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("unsupported OpenTelemetry protocol %q", protocol)
+	}
+}
+
+// AddOTelExport adds to the manager the periodic push of the Camel Apps KPIs to the configured OpenTelemetry
+// collector. It does nothing if no collector endpoint is configured.
+func AddOTelExport(ctx context.Context, mgr manager.Manager) error {
+	endpoint := platform.GetOTelExportEndpoint()
+	if endpoint == "" {
+		return nil
+	}
+	exporter, err := NewOTelExporter(ctx, endpoint, platform.GetOTelExportProtocol())
+	if err != nil {
+		return err
+	}
+	log.Infof("Exporting the Camel Apps KPIs to the OpenTelemetry collector %s (%s)", endpoint, platform.GetOTelExportProtocol())
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := exporter.Shutdown(shutdownCtx); err != nil {
+				log.Error(err, "Some error happened while shutting down the OpenTelemetry exporter")
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(platform.GetOTelExportInterval()):
+			}
+			if err := ExportAppMetrics(ctx, exporter); err != nil {
+				log.Error(err, "Some error happened while exporting the Camel Apps KPIs to the OpenTelemetry collector")
+			}
+		}
+	}))
+}
+
+// ExportAppMetrics pushes the KPIs of the Camel Apps through the exporter. Each App is exported as its own resource,
+// identified by the namespace, name, runtime provider and Camel version attributes. As the exporter takes a single
+// resource at a time, it costs one OTLP request per App at each export interval.
+func ExportAppMetrics(ctx context.Context, exporter sdkmetric.Exporter) error {
+	return appMetrics.export(ctx, exporter)
+}
+
+func (c *appCollector) export(ctx context.Context, exporter sdkmetric.Exporter) error {
+	var errs []error
+	for _, rm := range c.resourceMetrics() {
+		if err := exporter.Export(ctx, rm); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *appCollector) resourceMetrics() []*metricdata.ResourceMetrics {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	now := c.now()
+	resourceMetrics := make([]*metricdata.ResourceMetrics, 0, len(c.apps))
+	for _, app := range c.apps {
+		resourceMetrics = append(resourceMetrics, &metricdata.ResourceMetrics{
+			Resource: appResource(app),
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope:   instrumentation.Scope{Name: otelScopeName},
+				Metrics: appOTelMetrics(app, now),
+			}},
+		})
+	}
+
+	return resourceMetrics
+}
+
+func appResource(app appSnapshot) *resource.Resource {
+	attributes := []attribute.KeyValue{
+		semconv.K8SNamespaceName(app.labels[0]),
+		semconv.ServiceNamespace(app.labels[0]),
+		semconv.ServiceName(app.labels[1]),
+	}
+	if app.labels[2] != "" {
+		attributes = append(attributes, camelRuntimeAttribute.String(app.labels[2]))
+	}
+	if app.labels[3] != "" {
+		attributes = append(attributes, camelVersionAttribute.String(app.labels[3]))
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attributes...)
+}
+
+func appOTelMetrics(app appSnapshot, now time.Time) []metricdata.Metrics {
+	gauge := func(name, description, unit string, value int64, attributes ...attribute.KeyValue) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: description,
+			Unit:        unit,
+			Data: metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{
+					Attributes: attribute.NewSet(attributes...),
+					Time:       now,
+					Value:      value,
+				}},
+			},
+		}
+	}
+	// The exchanges totals restart from zero with a Pod, hence the start time of the cumulative sums
+	start := now
+	if app.exchangesStart != nil {
+		start = *app.exchangesStart
+	}
+	counter := func(name, description, unit string, value int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: description,
+			Unit:        unit,
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{{
+					StartTime: start,
+					Time:      now,
+					Value:     value,
+				}},
+			},
+		}
+	}
+	floatGauge := func(name, description, unit string, value float64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: description,
+			Unit:        unit,
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{{
+					Time:  now,
+					Value: value,
+				}},
+			},
+		}
+	}
+
+	var metrics []metricdata.Metrics
+	if app.phase != "" {
+		metrics = append(metrics, gauge("camel.dashboard.app.phase",
+			"Camel App phase, as 1 for the current phase", "1", 1, phaseAttribute.String(app.phase)))
+	}
+	if app.replicas != nil && *app.replicas >= 0 {
+		metrics = append(metrics, gauge("camel.dashboard.app.replicas",
+			"Camel App replicas", "{replica}", int64(*app.replicas)))
+	}
+	metrics = append(metrics, gauge("camel.dashboard.app.ready_pods",
+		"Camel App ready Pods", "{pod}", int64(app.readyPods)))
+	if app.successPercentage != nil {
+		metrics = append(metrics, floatGauge("camel.dashboard.app.sli.exchange.success_percentage",
+			"Camel App exchange success percentage over the last sampling interval", "%", *app.successPercentage))
+	}
+	if app.sliStatus != "" {
+		metrics = append(metrics, gauge("camel.dashboard.app.sli.exchange.status",
+			"Camel App exchange SLI status, as 1 for the current status", "1", 1, statusAttribute.String(app.sliStatus)))
+	}
+	metrics = append(metrics,
+		counter("camel.dashboard.app.exchanges.total",
+			"Camel App total number of exchanges across all the Pods", "{exchange}", int64(app.exchangesTotal)),
+		counter("camel.dashboard.app.exchanges.failed",
+			"Camel App total number of failed exchanges across all the Pods", "{exchange}", int64(app.exchangesFailed)),
+		gauge("camel.dashboard.app.exchanges.inflight",
+			"Camel App number of inflight exchanges across all the Pods", "{exchange}", int64(app.exchangesInflight)),
+	)
+	if app.lastExchange != nil {
+		metrics = append(metrics, floatGauge("camel.dashboard.app.last_exchange.age",
+			"Camel App time elapsed since the last exchange", "s", now.Sub(*app.lastExchange).Seconds()))
+	}
+
+	return metrics
+}
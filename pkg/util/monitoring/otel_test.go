@@ -0,0 +1,169 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/apis/camel/v1alpha1"
+	"github.com/camel-tooling/camel-dashboard-operator/pkg/platform"
+)
+
+type otlpReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	requests chan *colmetricspb.ExportMetricsServiceRequest
+}
+
+func (r *otlpReceiver) Export(_ context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	r.requests <- req
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func newOTelTestCollector() *appCollector {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	collector := &appCollector{
+		apps: map[string]appSnapshot{},
+		now:  func() time.Time { return now },
+	}
+	app := v1alpha1.NewApp("ns", "my-app")
+	app.Status = v1alpha1.CamelAppStatus{
+		Phase:    v1alpha1.CamelAppPhaseRunning,
+		Replicas: ptr.To(int32(1)),
+		Pods: []v1alpha1.PodInfo{
+			{
+				Ready:           true,
+				UptimeTimestamp: &metav1.Time{Time: now.Add(-time.Hour)},
+				Runtime: &v1alpha1.RuntimeInfo{
+					RuntimeProvider: "Quarkus",
+					CamelVersion:    "4.10.0",
+					Exchange:        &v1alpha1.ExchangeInfo{Total: 10, Failed: 1},
+				},
+			},
+		},
+		SuccessRate: &v1alpha1.SLIExchangeSuccessRate{
+			SuccessPercentage: "90.00",
+			Status:            v1alpha1.SLIExchangeStatusWarning,
+		},
+	}
+	collector.update(&app)
+
+	return collector
+}
+
+func assertOTelRequest(t *testing.T, req *colmetricspb.ExportMetricsServiceRequest) {
+	t.Helper()
+	require.Len(t, req.GetResourceMetrics(), 1)
+	rm := req.GetResourceMetrics()[0]
+	attributes := map[string]string{}
+	for _, kv := range rm.GetResource().GetAttributes() {
+		attributes[kv.GetKey()] = kv.GetValue().GetStringValue()
+	}
+	assert.Equal(t, "ns", attributes["k8s.namespace.name"])
+	assert.Equal(t, "my-app", attributes["service.name"])
+	assert.Equal(t, "Quarkus", attributes["camel.runtime.provider"])
+	assert.Equal(t, "4.10.0", attributes["camel.version"])
+
+	require.Len(t, rm.GetScopeMetrics(), 1)
+	metrics := map[string]*metricspb.NumberDataPoint{}
+	for _, m := range rm.GetScopeMetrics()[0].GetMetrics() {
+		if sum := m.GetSum(); sum != nil {
+			assert.True(t, sum.GetIsMonotonic())
+			assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.GetAggregationTemporality())
+			require.Len(t, sum.GetDataPoints(), 1)
+			metrics[m.GetName()] = sum.GetDataPoints()[0]
+			continue
+		}
+		require.Len(t, m.GetGauge().GetDataPoints(), 1)
+		metrics[m.GetName()] = m.GetGauge().GetDataPoints()[0]
+	}
+	assert.Equal(t, int64(10), metrics["camel.dashboard.app.exchanges.total"].GetAsInt())
+	assert.Equal(t, int64(1), metrics["camel.dashboard.app.exchanges.failed"].GetAsInt())
+	assert.Equal(t, uint64(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC).UnixNano()), metrics["camel.dashboard.app.exchanges.total"].GetStartTimeUnixNano())
+	assert.Equal(t, int64(1), metrics["camel.dashboard.app.ready_pods"].GetAsInt())
+	assert.InDelta(t, 90.0, metrics["camel.dashboard.app.sli.exchange.success_percentage"].GetAsDouble(), 0.001)
+	status := metrics["camel.dashboard.app.sli.exchange.status"]
+	require.NotNil(t, status)
+	require.Len(t, status.GetAttributes(), 1)
+	assert.Equal(t, string(v1alpha1.SLIExchangeStatusWarning), status.GetAttributes()[0].GetValue().GetStringValue())
+	phase := metrics["camel.dashboard.app.phase"]
+	require.NotNil(t, phase)
+	assert.Equal(t, string(v1alpha1.CamelAppPhaseRunning), phase.GetAttributes()[0].GetValue().GetStringValue())
+}
+
+func TestExportAppMetricsHTTP(t *testing.T) {
+	requests := make(chan *colmetricspb.ExportMetricsServiceRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/metrics", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &colmetricspb.ExportMetricsServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, req))
+		requests <- req
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	exporter, err := NewOTelExporter(ctx, server.URL, platform.OTelProtocolHTTP)
+	require.NoError(t, err)
+	defer func() { _ = exporter.Shutdown(ctx) }()
+
+	require.NoError(t, newOTelTestCollector().export(ctx, exporter))
+	assertOTelRequest(t, <-requests)
+}
+
+func TestExportAppMetricsGRPC(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	receiver := &otlpReceiver{requests: make(chan *colmetricspb.ExportMetricsServiceRequest, 1)}
+	server := grpc.NewServer()
+	colmetricspb.RegisterMetricsServiceServer(server, receiver)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	ctx := context.Background()
+	exporter, err := NewOTelExporter(ctx, "http://"+listener.Addr().String(), platform.OTelProtocolGRPC)
+	require.NoError(t, err)
+	defer func() { _ = exporter.Shutdown(ctx) }()
+
+	require.NoError(t, newOTelTestCollector().export(ctx, exporter))
+	assertOTelRequest(t, <-receiver.requests)
+}
+
+func TestNewOTelExporterInvalid(t *testing.T) {
+	_, err := NewOTelExporter(context.Background(), "otel-collector", platform.OTelProtocolHTTP)
+	require.Error(t, err)
+	_, err = NewOTelExporter(context.Background(), "http://otel-collector:4318", "thrift")
+	require.Error(t, err)
+}
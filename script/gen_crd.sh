@@ -63,3 +63,6 @@ deploy_crd() {
 }
 
 deploy_crd camelapp camelapps
+deploy_crd cameldashboardconfig cameldashboardconfigs
+deploy_crd camelfleet camelfleets
+deploy_crd camelappgroup camelappgroups
@@ -36,11 +36,17 @@ mkdir -p openshift-ecosystem/$1/metadata/
 mkdir -p openshift-ecosystem/$1/tests/scorecard/
 
 cp ./manifests/camel.apache.org_camelapps.yaml k8s-operatorhub/$1/manifests/camelapps.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_cameldashboardconfigs.yaml k8s-operatorhub/$1/manifests/cameldashboardconfigs.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_camelfleets.yaml k8s-operatorhub/$1/manifests/camelfleets.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_camelappgroups.yaml k8s-operatorhub/$1/manifests/camelappgroups.camel.apache.org.crd.yaml
 cp ./manifests/camel-dashboard.clusterserviceversion.yaml k8s-operatorhub/$1/manifests/camel-dashboard.v$1.clusterserviceversion.yaml
 cp ./metadata/annotations.yaml k8s-operatorhub/$1/metadata/annotations.yaml
 cp ./tests/scorecard/config.yaml k8s-operatorhub/$1/tests/scorecard/config.yaml
 
 cp ./manifests/camel.apache.org_camelapps.yaml openshift-ecosystem/$1/manifests/camelapps.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_cameldashboardconfigs.yaml openshift-ecosystem/$1/manifests/cameldashboardconfigs.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_camelfleets.yaml openshift-ecosystem/$1/manifests/camelfleets.camel.apache.org.crd.yaml
+cp ./manifests/camel.apache.org_camelappgroups.yaml openshift-ecosystem/$1/manifests/camelappgroups.camel.apache.org.crd.yaml
 cp ./manifests/camel-dashboard.clusterserviceversion.yaml openshift-ecosystem/$1/manifests/camel-dashboard.v$1.clusterserviceversion.yaml
 cp ./metadata/annotations.yaml openshift-ecosystem/$1/metadata/annotations.yaml
 cp ./tests/scorecard/config.yaml openshift-ecosystem/$1/tests/scorecard/config.yaml